@@ -12,7 +12,10 @@ import (
 	"github.com/hashicorp/vault/sdk/framework"
 )
 
-var pathToOpenAPIDoc = flag.String("openapi-doc", "", "path/to/openapi.json")
+var (
+	pathToOpenAPIDoc = flag.String("openapi-doc", "", "path/to/openapi.json")
+	dryRun           = flag.Bool("dry-run", false, "print the files that would be generated instead of writing them")
+)
 
 func main() {
 	logger := hclog.Default()
@@ -34,6 +37,13 @@ func main() {
 		os.Exit(1)
 	}
 
+	if *dryRun {
+		if err := codegen.DryRun(logger, oasDoc.Paths, os.Stdout); err != nil {
+			logger.Error("Failed to generate code: %s", err.Error())
+			os.Exit(1)
+		}
+		return
+	}
 	if err := codegen.Run(logger, oasDoc.Paths); err != nil {
 		logger.Error("Failed to generate code: %s", err.Error())
 		os.Exit(1)
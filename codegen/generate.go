@@ -4,11 +4,14 @@ import (
 	"bufio"
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"path/filepath"
+	"regexp"
 	"strings"
 
 	"github.com/hashicorp/go-hclog"
+	"github.com/hashicorp/terraform-provider-vault/util"
 	"github.com/hashicorp/vault/sdk/framework"
 )
 
@@ -18,9 +21,21 @@ const generatedDirPerms os.FileMode = 0775
 
 var errUnsupported = errors.New("code and doc generation for this item is unsupported")
 
+var pathParamRegex = regexp.MustCompile(`{([^}]+)}`)
+
 // Run accepts a map of endpoint paths and generates both code and documentation
 // for NEW endpoints in the endpoint registry.
 func Run(logger hclog.Logger, paths map[string]*framework.OASPathItem) error {
+	return run(logger, paths, nil)
+}
+
+// DryRun is like Run, but rather than writing any files it prints the path of
+// each file that would be generated, followed by its rendered content, to out.
+func DryRun(logger hclog.Logger, paths map[string]*framework.OASPathItem, out io.Writer) error {
+	return run(logger, paths, out)
+}
+
+func run(logger hclog.Logger, paths map[string]*framework.OASPathItem, dryRunOut io.Writer) error {
 	// Read in the templates we'll be using.
 	h, err := newTemplateHandler(logger)
 	if err != nil {
@@ -31,10 +46,17 @@ func Run(logger hclog.Logger, paths map[string]*framework.OASPathItem) error {
 	fCreator := &fileCreator{
 		logger:          logger,
 		templateHandler: h,
+		dryRunOut:       dryRunOut,
 	}
 	createdCount := 0
 	skippedCount := 0
 	for endpoint, addedInfo := range endpointRegistry {
+		if paths[endpoint] == nil {
+			return fmt.Errorf("%s was not found in the OpenAPI document", endpoint)
+		}
+		if err := validatePathParameters(endpoint); err != nil {
+			return err
+		}
 		if err := fCreator.GenerateCode(endpoint, paths[endpoint], addedInfo); err != nil {
 			if err == errUnsupported {
 				logger.Warn(fmt.Sprintf("couldn't generate %s, continuing", endpoint))
@@ -64,6 +86,10 @@ func Run(logger hclog.Logger, paths map[string]*framework.OASPathItem) error {
 type fileCreator struct {
 	logger          hclog.Logger
 	templateHandler *templateHandler
+
+	// dryRunOut is only populated for dry runs, in which case rendered files
+	// are written to it instead of to disk.
+	dryRunOut io.Writer
 }
 
 // GenerateCode is exported because it's the only method intended to be used by
@@ -101,6 +127,12 @@ func (c *fileCreator) GenerateDoc(endpoint string, endpointInfo *framework.OASPa
 }
 
 func (c *fileCreator) writeFile(pathToFile string, tmplTp templateType, endpoint string, endpointInfo *framework.OASPathItem, addedInfo *additionalInfo) error {
+	if c.dryRunOut != nil {
+		if _, err := fmt.Fprintf(c.dryRunOut, "==> %s\n", pathToFile); err != nil {
+			return err
+		}
+		return c.templateHandler.Write(c.dryRunOut, tmplTp, endpoint, endpointInfo, addedInfo)
+	}
 	wr, closer, err := c.createFileWriter(pathToFile)
 	if err != nil {
 		return err
@@ -142,6 +174,29 @@ func (c *fileCreator) createFileWriter(pathToFile string) (wr *bufio.Writer, clo
 	return wr, closer, nil
 }
 
+// validatePathParameters ensures the generated code will be able to recover
+// every path parameter from a Vault path built from the endpoint, since both
+// reads and imports rely on util.PathParameters to do so.
+func validatePathParameters(endpoint string) error {
+	vaultPath := endpoint
+	expected := make(map[string]string)
+	for _, match := range pathParamRegex.FindAllStringSubmatch(endpoint, -1) {
+		val := "sample-" + match[1]
+		expected[match[1]] = val
+		vaultPath = strings.Replace(vaultPath, match[0], val, 1)
+	}
+	params, err := util.PathParameters(endpoint, vaultPath)
+	if err != nil {
+		return fmt.Errorf("unable to parse path parameters for %s: %s", endpoint, err)
+	}
+	for name, val := range expected {
+		if params[name] != val {
+			return fmt.Errorf("unable to parse path parameters for %s: expected %q for %q but received %q", endpoint, val, name, params[name])
+		}
+	}
+	return nil
+}
+
 /*
 codeFilePath creates a directory structure inside the "generated" folder that's
 intended to make it easy to find the file for each endpoint in Vault, even if
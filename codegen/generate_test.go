@@ -139,3 +139,20 @@ func TestStripCurlyBraces(t *testing.T) {
 		})
 	}
 }
+
+func TestValidatePathParameters(t *testing.T) {
+	for _, endpoint := range []string{
+		"/transform/role/{name}",
+		"/transform/decode/{role_name}",
+		"/transit/export/{type}/{name}/{version}",
+		"/auth/userpass/users/{username}/password",
+		"/auth/approle/tidy/secret-id",
+	} {
+		if err := validatePathParameters(endpoint); err != nil {
+			t.Errorf("%s: %s", endpoint, err)
+		}
+	}
+	if err := validatePathParameters("transform/role/{name}"); err == nil {
+		t.Error("expected an error for an endpoint without a leading slash")
+	}
+}
@@ -107,6 +107,7 @@ func (h *templateHandler) toTemplatable(endpoint string, endpointInfo *framework
 		SupportsRead:            endpointInfo.Get != nil,
 		SupportsWrite:           endpointInfo.Post != nil,
 		SupportsDelete:          endpointInfo.Delete != nil,
+		Deprecated:              isDeprecated(endpointInfo),
 	}
 	if err := t.Validate(); err != nil {
 		return nil, errwrap.Wrapf("failed to validate templatable data for "+endpoint+": {{err}}", err)
@@ -114,6 +115,17 @@ func (h *templateHandler) toTemplatable(endpoint string, endpointInfo *framework
 	return t, nil
 }
 
+// isDeprecated reports whether any of the endpoint's operations are flagged
+// as deprecated in the OpenAPI document.
+func isDeprecated(endpointInfo *framework.OASPathItem) bool {
+	for _, op := range []*framework.OASOperation{endpointInfo.Get, endpointInfo.Post, endpointInfo.Delete} {
+		if op != nil && op.Deprecated {
+			return true
+		}
+	}
+	return false
+}
+
 // parseParameters walks a PathItem and looks for all the parameters
 // described. Some are at the top level of the path, indicating they are
 // path parameters. Others are only in the post body. It returns them
@@ -139,6 +151,7 @@ func parseParameters(endpointInfo *framework.OASPathItem, addedInfo *additionalI
 				Description: schema.Description,
 				In:          "post",
 				Schema:      schema,
+				Deprecated:  schema.Deprecated,
 			}
 			result = append(result, toTemplatableParam(param, false))
 		}
@@ -189,6 +202,10 @@ func toTemplatableParam(param framework.OASParameter, isPathParameter bool) temp
 	if ptrToParam.Schema.DisplayAttrs == nil {
 		ptrToParam.Schema.DisplayAttrs = &framework.DisplayAttributes{}
 	}
+	// Deprecation may be flagged on either the parameter or its schema.
+	if ptrToParam.Schema.Deprecated {
+		ptrToParam.Deprecated = true
+	}
 	return templatableParam{
 		OASParameter: ptrToParam,
 		IsPathParam:  isPathParameter,
@@ -208,6 +225,7 @@ type templatableEndpoint struct {
 	SupportsRead            bool
 	SupportsWrite           bool
 	SupportsDelete          bool
+	Deprecated              bool
 }
 
 func (e *templatableEndpoint) Validate() error {
@@ -17,6 +17,9 @@ const {{ .LowerCaseDifferentiator }}Endpoint = "{{ .Endpoint }}"
 
 func {{ .UpperCaseDifferentiator }}DataSource() *schema.Resource {
 	return &schema.Resource{
+		{{- if .Deprecated }}
+		DeprecationMessage: "This endpoint is deprecated by Vault and may be removed in a future release.",
+		{{- end }}
         Read: read{{ .UpperCaseDifferentiator }}Resource,
 		Schema: map[string]*schema.Schema{
 			"path": {
@@ -60,6 +63,9 @@ func {{ .UpperCaseDifferentiator }}DataSource() *schema.Resource {
                 Computed:    true,
                 {{- end }}
 				Description: "{{ .Description }}",
+				{{- if .Deprecated }}
+				Deprecated:  "This field is deprecated by Vault and may be removed in a future release.",
+				{{- end }}
 			},
 			{{- end }}
 		},
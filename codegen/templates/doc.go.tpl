@@ -9,6 +9,10 @@ description: |-
 # <TODO>
 
 <TODO>
+{{- if .Deprecated }}
+
+~> **Deprecated:** The `{{ .Endpoint }}` endpoint is deprecated by Vault and may be removed in a future release.
+{{- end }}
 
 ## Example Usage
 
@@ -27,5 +31,5 @@ resource "vault_jwt_auth_backend" "example" {
 The following arguments are supported:
 * `path` - (Required) Path to where the back-end is mounted within Vault.
 {{- range .Parameters }}
-* `{{ .Name }}` - {{ if .Required }}(Required){{ else }}(Optional){{ end }} {{ .Description }}
+* `{{ .Name }}` - {{ if .Required }}(Required){{ else }}(Optional){{ end }}{{ if .Deprecated }} **Deprecated**{{ end }} {{ .Description }}
 {{- end }}
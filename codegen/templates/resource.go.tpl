@@ -62,6 +62,9 @@ func {{ .UpperCaseDifferentiator }}Resource() *schema.Resource {
 			Sensitive:   true,
 			{{- end }}
 			Description: `{{ .Description }}`,
+			{{- if .Deprecated }}
+			Deprecated:  "This field is deprecated by Vault and may be removed in a future release.",
+			{{- end }}
 			{{- if .IsPathParam }}
 			ForceNew: true,
 			{{- end}}
@@ -69,6 +72,9 @@ func {{ .UpperCaseDifferentiator }}Resource() *schema.Resource {
 		{{- end }}
 	}
 	return &schema.Resource{
+		{{- if .Deprecated }}
+		DeprecationMessage: "This endpoint is deprecated by Vault and may be removed in a future release.",
+		{{- end }}
 		{{- if .SupportsWrite }}
 		Create: create{{ .UpperCaseDifferentiator }}Resource,
 		Update: update{{ .UpperCaseDifferentiator }}Resource,
@@ -80,9 +86,11 @@ func {{ .UpperCaseDifferentiator }}Resource() *schema.Resource {
 		{{- if or .SupportsDelete .SupportsWrite  }}
 		Delete: delete{{ .UpperCaseDifferentiator }}Resource,
 		{{- end }}
+		{{- if .SupportsRead }}
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: import{{ .UpperCaseDifferentiator }}Resource,
 		},
+		{{- end }}
 		Schema: fields,
 	}
 }
@@ -220,4 +228,24 @@ func resource{{ .UpperCaseDifferentiator }}Exists(d *schema.ResourceData, meta i
 	log.Printf("[DEBUG] Checked if %q exists", vaultPath)
 	return resp != nil, nil
 }
+
+func import{{ .UpperCaseDifferentiator }}Resource(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	vaultPath := d.Id()
+	if !strings.HasPrefix(vaultPath, "/") {
+		vaultPath = "/" + vaultPath
+	}
+	log.Printf("[DEBUG] Importing %q", vaultPath)
+
+	pathParams, err := util.PathParameters({{ .LowerCaseDifferentiator }}Endpoint, vaultPath)
+	if err != nil {
+		return nil, fmt.Errorf("invalid import ID %q: %s", d.Id(), err)
+	}
+	for paramName, paramVal := range pathParams {
+		if err := d.Set(paramName, paramVal); err != nil {
+			return nil, fmt.Errorf("error setting state %q, %q: %s", paramName, paramVal, err)
+		}
+	}
+	d.SetId(vaultPath)
+	return []*schema.ResourceData{d}, nil
+}
 {{- end }}
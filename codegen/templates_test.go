@@ -494,6 +494,60 @@ func TestParseParameters(t *testing.T) {
 	}
 }
 
+func TestParseParameters_deprecated(t *testing.T) {
+	endpointInfo := &framework.OASPathItem{}
+	if err := json.Unmarshal([]byte(`{
+	"parameters": [{
+		"name": "name",
+		"in": "path",
+		"schema": {
+			"type": "string"
+		},
+		"required": true
+	}],
+	"post": {
+		"deprecated": true,
+		"requestBody": {
+			"content": {
+				"application/json": {
+					"schema": {
+						"type": "object",
+						"properties": {
+							"old_field": {
+								"type": "string",
+								"deprecated": true
+							},
+							"new_field": {
+								"type": "string"
+							}
+						}
+					}
+				}
+			}
+		}
+	}
+}`), endpointInfo); err != nil {
+		t.Fatal(err)
+	}
+	if !isDeprecated(endpointInfo) {
+		t.Fatal("expected the endpoint to be deprecated")
+	}
+	expected := map[string]bool{
+		"name":      false,
+		"new_field": false,
+		"old_field": true,
+	}
+	parameters := parseParameters(endpointInfo, &additionalInfo{Type: tfTypeResource})
+	if len(parameters) != len(expected) {
+		t.Fatalf("expected %d parameters but received %d", len(expected), len(parameters))
+	}
+	for _, param := range parameters {
+		if param.Deprecated != expected[param.Name] {
+			t.Fatalf("expected deprecated to be %t for %q", expected[param.Name], param.Name)
+		}
+	}
+}
+
 func TestTemplateHandler(t *testing.T) {
 	h, err := newTemplateHandler(hclog.Default())
 	if err != nil {
@@ -581,4 +635,7 @@ func TestTemplateHandler(t *testing.T) {
 	if !strings.Contains(result, "resourceNameExists") {
 		t.Fatalf("unexpected result: %s", result)
 	}
+	if !strings.Contains(result, "State: importNameResource") {
+		t.Fatalf("unexpected result: %s", result)
+	}
 }
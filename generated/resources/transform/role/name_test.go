@@ -54,10 +54,36 @@ func TestRoleName(t *testing.T) {
 				ImportState:       true,
 				ImportStateVerify: true,
 			},
+			{
+				ResourceName:      "vault_transform_role_name.test",
+				ImportState:       true,
+				ImportStateId:     fmt.Sprintf("%s/role/%s", path, role),
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
 
+func TestRoleNameImport(t *testing.T) {
+	d := NameResource().TestResourceData()
+	d.SetId("/my/transform/role/my-role")
+	if _, err := importNameResource(d, nil); err != nil {
+		t.Fatal(err)
+	}
+	if actual := d.Get("path").(string); actual != "my/transform" {
+		t.Fatalf("expected path %q but received %q", "my/transform", actual)
+	}
+	if actual := d.Get("name").(string); actual != "my-role" {
+		t.Fatalf("expected name %q but received %q", "my-role", actual)
+	}
+
+	d = NameResource().TestResourceData()
+	d.SetId("/my/transform/alphabet/my-role")
+	if _, err := importNameResource(d, nil); err == nil {
+		t.Fatal("expected an error importing a path that isn't a role")
+	}
+}
+
 func destroy(s *terraform.State) error {
 	client := nameTestProvider.SchemaProvider().Meta().(*api.Client)
 
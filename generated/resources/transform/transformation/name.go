@@ -73,7 +73,7 @@ func NameResource() *schema.Resource {
 		Exists: resourceNameExists,
 		Delete: deleteNameResource,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: importNameResource,
 		},
 		Schema: fields,
 	}
@@ -224,3 +224,23 @@ func resourceNameExists(d *schema.ResourceData, meta interface{}) (bool, error)
 	log.Printf("[DEBUG] Checked if %q exists", vaultPath)
 	return resp != nil, nil
 }
+
+func importNameResource(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	vaultPath := d.Id()
+	if !strings.HasPrefix(vaultPath, "/") {
+		vaultPath = "/" + vaultPath
+	}
+	log.Printf("[DEBUG] Importing %q", vaultPath)
+
+	pathParams, err := util.PathParameters(nameEndpoint, vaultPath)
+	if err != nil {
+		return nil, fmt.Errorf("invalid import ID %q: %s", d.Id(), err)
+	}
+	for paramName, paramVal := range pathParams {
+		if err := d.Set(paramName, paramVal); err != nil {
+			return nil, fmt.Errorf("error setting state %q, %q: %s", paramName, paramVal, err)
+		}
+	}
+	d.SetId(vaultPath)
+	return []*schema.ResourceData{d}, nil
+}
@@ -19,4 +19,7 @@ require (
 	github.com/hashicorp/vault/sdk v0.1.14-0.20210526173046-412db2245e81
 	github.com/mitchellh/go-homedir v1.1.0
 	github.com/rainycape/unidecode v0.0.0-20150907023854-cb7f23ec59be // indirect
+	github.com/zclconf/go-cty v1.2.1
+	github.com/zclconf/go-cty-yaml v1.0.1
+	golang.org/x/crypto v0.0.0-20200604202706-70a84ac30bf9
 )
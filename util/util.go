@@ -7,6 +7,7 @@ import (
 	"os"
 	"reflect"
 	"regexp"
+	"strconv"
 	"strings"
 	"testing"
 	"time"
@@ -31,6 +32,34 @@ func JsonDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
 	return reflect.DeepEqual(oldJSON, newJSON)
 }
 
+// ParseDurationSeconds parses a value that Vault accepts as either a number
+// of seconds or a duration string like "24h", returning the number of seconds.
+func ParseDurationSeconds(v string) (int64, error) {
+	if seconds, err := strconv.ParseInt(v, 10, 64); err == nil {
+		return seconds, nil
+	}
+	d, err := time.ParseDuration(v)
+	if err != nil {
+		return 0, err
+	}
+	return int64(d.Seconds()), nil
+}
+
+// DurationSecondsDiffSuppress suppresses the diff between a duration string in
+// the configuration and the number of seconds Vault returns for it, e.g. "24h"
+// and "86400".
+func DurationSecondsDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	oldSeconds, err := ParseDurationSeconds(old)
+	if err != nil {
+		return false
+	}
+	newSeconds, err := ParseDurationSeconds(new)
+	if err != nil {
+		return false
+	}
+	return oldSeconds == newSeconds
+}
+
 func ToStringArray(input []interface{}) []string {
 	output := make([]string, len(input))
 
@@ -45,6 +74,10 @@ func Is404(err error) bool {
 	return strings.Contains(err.Error(), "Code: 404")
 }
 
+func IsPermissionDenied(err error) bool {
+	return strings.Contains(err.Error(), "Code: 403")
+}
+
 func CalculateConflictsWith(self string, group []string) []string {
 	if len(group) < 2 {
 		return []string{}
@@ -231,6 +264,9 @@ func SliceRemoveIfPresent(list []interface{}, search interface{}) []interface{}
 //   - userSuppliedPath = "transform"
 //   - endpoint = "/transform/role/{name}"
 //   - parameters will include path parameters
+//
+// The result is relative to the client's namespace, which is sent as a
+// header, so it never includes the namespace.
 func ParsePath(userSuppliedPath, endpoint string, d *schema.ResourceData) string {
 	fields := strings.Split(endpoint, "/")
 	if fields[0] == "" {
@@ -276,6 +312,8 @@ func ParsePath(userSuppliedPath, endpoint string, d *schema.ResourceData) string
 // PathParameters is just like regexp FindStringSubmatch,
 // but it validates that the match is different from the string passed
 // in, and that there's only one result.
+// Like ParsePath, it is namespace-neutral: vaultPath must not include the
+// namespace, e.g. for imports.
 func PathParameters(endpoint, vaultPath string) (map[string]string, error) {
 	fields := strings.Split(endpoint, "/")
 
@@ -298,17 +336,31 @@ func PathParameters(endpoint, vaultPath string) (map[string]string, error) {
 	}
 	fields[0] = "{path}"
 
+	last := 0
+	for i, field := range fields {
+		if strings.HasPrefix(field, "{") {
+			last = i
+		}
+	}
+
+	// Parameters may contain slashes. Intermediate parameters match lazily
+	// so they stop at the first following literal segment, while the last
+	// one takes the remainder of the path.
 	for i, field := range fields {
 		if strings.HasPrefix(field, "{") {
+			match := ">.+?)"
+			if i == last {
+				match = ">.+)"
+			}
 			fields[i] = strings.ReplaceAll(fields[i], "{", "(?P<")
-			fields[i] = strings.ReplaceAll(fields[i], "}", ">.+)")
+			fields[i] = strings.ReplaceAll(fields[i], "}", match)
 		}
 	}
-	pattern := "/"
+	pattern := "^/"
 	if isAuthEndpoint {
 		pattern += "auth/"
 	}
-	pattern += strings.Join(fields, "/")
+	pattern += strings.Join(fields, "/") + "$"
 
 	endpointReg, err := regexp.Compile(pattern)
 	if err != nil {
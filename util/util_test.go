@@ -2,9 +2,13 @@ package util
 
 import (
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"net/http"
+	"net/http/httptest"
 	"reflect"
 	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
 )
 
 type testingStruct struct {
@@ -27,6 +31,15 @@ func TestExpiredTokenError(t *testing.T) {
 	}
 }
 
+func TestIsPermissionDenied(t *testing.T) {
+	if ok := IsPermissionDenied(fmt.Errorf("Error making API request.\n\nCode: 403. Errors:\n\n* permission denied")); !ok {
+		t.Errorf("Should be permission denied")
+	}
+	if ok := IsPermissionDenied(fmt.Errorf("Error making API request.\n\nCode: 400. Errors:\n\n* invalid request")); ok {
+		t.Errorf("Shouldn't be permission denied")
+	}
+}
+
 func TestSliceHasElement_scalar(t *testing.T) {
 	slice := []interface{}{1, 2, 3, 4, 5}
 
@@ -233,6 +246,34 @@ func TestPathParameters(t *testing.T) {
 				"path": "my-approle",
 			},
 		},
+		{
+			endpoint:  "/transform/role/{name}/something/{id}",
+			vaultPath: "/my/transform/role/my-role/something/my-id",
+			expected: map[string]string{
+				"path": "my/transform",
+				"name": "my-role",
+				"id":   "my-id",
+			},
+		},
+		{
+			endpoint:  "/transform/role/{name}/something/{id}",
+			vaultPath: "/my/transform/role/my/nested/role/something/my/nested/id",
+			expected: map[string]string{
+				"path": "my/transform",
+				"name": "my/nested/role",
+				"id":   "my/nested/id",
+			},
+		},
+		{
+			endpoint:  "/transit/export/{type}/{name}/{version}",
+			vaultPath: "/transit/export/encryption-key/my-key/1",
+			expected: map[string]string{
+				"path":    "transit",
+				"type":    "encryption-key",
+				"name":    "my-key",
+				"version": "1",
+			},
+		},
 		{
 			endpoint:  "/sys/mfa/method/totp/{name}/admin-generate",
 			vaultPath: "/sys/mfa/method/totp/my_totp/admin-generate",
@@ -254,3 +295,75 @@ func TestPathParameters(t *testing.T) {
 		})
 	}
 }
+
+// The namespace is sent as a header, so the paths built by ParsePath and the
+// parameters recovered by PathParameters must not depend on it.
+func TestPathNamespaceNeutral(t *testing.T) {
+	endpoint := "/transform/role/{name}"
+	d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{
+		"name": {Type: schema.TypeString},
+	}, map[string]interface{}{
+		"name": "payments",
+	})
+	vaultPath := ParsePath("transform", endpoint, d)
+
+	var requestPath, requestNamespace string
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requestPath = r.URL.Path
+		requestNamespace = r.Header.Get("X-Vault-Namespace")
+		w.WriteHeader(http.StatusNoContent)
+	}))
+	defer server.Close()
+
+	for _, namespace := range []string{"", "ns1", "ns1/ns2"} {
+		t.Run(namespace, func(t *testing.T) {
+			config := api.DefaultConfig()
+			config.Address = server.URL
+			client, err := api.NewClient(config)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if namespace != "" {
+				client.SetNamespace(namespace)
+			}
+
+			if _, err := client.Logical().Write(vaultPath, map[string]interface{}{}); err != nil {
+				t.Fatal(err)
+			}
+			if expected := "/v1/transform/role/payments"; requestPath != expected {
+				t.Fatalf("expected request path %q, received %q", expected, requestPath)
+			}
+			if requestNamespace != namespace {
+				t.Fatalf("expected namespace %q, received %q", namespace, requestNamespace)
+			}
+
+			params, err := PathParameters(endpoint, vaultPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			expected := map[string]string{"path": "transform", "name": "payments"}
+			if !reflect.DeepEqual(params, expected) {
+				t.Fatalf("expected %+v but received %+v", expected, params)
+			}
+		})
+	}
+}
+
+func TestDurationSecondsDiffSuppress(t *testing.T) {
+	testCases := []struct {
+		old, new string
+		expected bool
+	}{
+		{old: "86400", new: "24h", expected: true},
+		{old: "86400", new: "86400", expected: true},
+		{old: "5400", new: "1h30m", expected: true},
+		{old: "86400", new: "48h", expected: false},
+		{old: "86400", new: "3600", expected: false},
+		{old: "86400", new: "tomorrow", expected: false},
+	}
+	for _, testCase := range testCases {
+		if actual := DurationSecondsDiffSuppress("rotation_period", testCase.old, testCase.new, nil); actual != testCase.expected {
+			t.Errorf("expected %q and %q suppressed to be %t but received %t", testCase.old, testCase.new, testCase.expected, actual)
+		}
+	}
+}
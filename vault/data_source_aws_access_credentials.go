@@ -8,6 +8,7 @@ import (
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/aws/awserr"
 	"github.com/aws/aws-sdk-go/aws/credentials"
+	"github.com/aws/aws-sdk-go/aws/endpoints"
 	"github.com/aws/aws-sdk-go/aws/session"
 	"github.com/aws/aws-sdk-go/service/iam"
 	"github.com/aws/aws-sdk-go/service/sts"
@@ -54,8 +55,8 @@ func awsAccessCredentialsDataSource() *schema.Resource {
 			"type": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Default:     "creds",
-				Description: "Type of credentials to read. Must be either 'creds' for Access Key and Secret Key, or 'sts' for STS.",
+				Computed:    true,
+				Description: "Type of credentials to read. Must be either 'creds' for Access Key and Secret Key, or 'sts' for STS. If not set, it's chosen based on the credential_type of the role.",
 				ValidateFunc: func(v interface{}, k string) (ws []string, errs []error) {
 					value := v.(string)
 					if value != "sts" && value != "creds" {
@@ -72,23 +73,26 @@ func awsAccessCredentialsDataSource() *schema.Resource {
 			"region": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				Description: "Region the read credentials belong to.",
+				Description: "Region the read credentials belong to. STS credentials are checked against the regional STS endpoint.",
 			},
 			"access_key": {
 				Type:        schema.TypeString,
 				Computed:    true,
+				Sensitive:   true,
 				Description: "AWS access key ID read from Vault.",
 			},
 
 			"secret_key": {
 				Type:        schema.TypeString,
 				Computed:    true,
+				Sensitive:   true,
 				Description: "AWS secret key read from Vault.",
 			},
 
 			"security_token": {
 				Type:        schema.TypeString,
 				Computed:    true,
+				Sensitive:   true,
 				Description: "AWS security token read from Vault. (Only returned if type is 'sts').",
 			},
 
@@ -120,6 +124,11 @@ func awsAccessCredentialsDataSource() *schema.Resource {
 				Optional:    true,
 				Description: "User specified Time-To-Live for the STS token. Uses the Role defined default_sts_ttl when not specified",
 			},
+			"role_session_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The role session name to use when assuming the role. Only valid for roles with credential_type assumed_role.",
+			},
 		},
 	}
 }
@@ -128,8 +137,11 @@ func awsAccessCredentialsDataSourceRead(d *schema.ResourceData, meta interface{}
 	client := meta.(*api.Client)
 
 	backend := d.Get("backend").(string)
-	credType := d.Get("type").(string)
 	role := d.Get("role").(string)
+	credType, err := awsAccessCredentialsType(d, client)
+	if err != nil {
+		return err
+	}
 	path := backend + "/" + credType + "/" + role
 
 	arn := d.Get("role_arn").(string)
@@ -141,6 +153,9 @@ func awsAccessCredentialsDataSourceRead(d *schema.ResourceData, meta interface{}
 	if v, ok := d.GetOk("ttl"); ok {
 		data["ttl"] = []string{v.(string)}
 	}
+	if v, ok := d.GetOk("role_session_name"); ok {
+		data["role_session_name"] = []string{v.(string)}
+	}
 
 	log.Printf("[DEBUG] Reading %q from Vault with data %#v", path, data)
 	secret, err := client.Logical().ReadWithData(path, data)
@@ -177,6 +192,7 @@ func awsAccessCredentialsDataSourceRead(d *schema.ResourceData, meta interface{}
 	region := d.Get("region").(string)
 	if region != "" {
 		awsConfig.Region = &region
+		awsConfig.STSRegionalEndpoint = endpoints.RegionalSTSEndpoint
 	}
 
 	sess, err := session.NewSession(awsConfig)
@@ -235,6 +251,38 @@ func awsAccessCredentialsDataSourceRead(d *schema.ResourceData, meta interface{}
 	return nil
 }
 
+// awsAccessCredentialsType returns the type of credentials to read, which
+// is "sts" for roles with STS credential types and "creds" otherwise, unless
+// it's been set explicitly.
+func awsAccessCredentialsType(d *schema.ResourceData, client *api.Client) (string, error) {
+	if v, ok := d.GetOk("type"); ok {
+		return v.(string), nil
+	}
+
+	path := d.Get("backend").(string) + "/roles/" + d.Get("role").(string)
+	log.Printf("[DEBUG] Reading role %q to pick the type of credentials", path)
+	role, err := client.Logical().Read(path)
+	if err != nil {
+		// The token may only be allowed to read credentials, and creds
+		// works for every credential type.
+		log.Printf("[WARN] Error reading role %q, reading creds: %s", path, err)
+		d.Set("type", "creds")
+		return "creds", nil
+	}
+	if role == nil {
+		return "", fmt.Errorf("no role found at path %q", path)
+	}
+
+	credType := "creds"
+	switch role.Data["credential_type"] {
+	case "assumed_role", "federation_token":
+		credType = "sts"
+	}
+	d.Set("type", credType)
+
+	return credType, nil
+}
+
 func isAWSAuthError(err error) bool {
 	awsErr, ok := err.(awserr.Error)
 	if !ok {
@@ -99,6 +99,29 @@ func TestAccDataSourceAWSAccessCredentials_sts(t *testing.T) {
 					region = "${vault_aws_secret_backend.aws.region}"
 				}`, mountPath, accessKey, secretKey, region),
 		},
+		"sts from the credential_type of the role": {
+			config: fmt.Sprintf(`
+				resource "vault_aws_secret_backend" "aws" {
+					path = "%s"
+					description = "Obtain AWS credentials."
+					access_key = "%s"
+					secret_key = "%s"
+					region = "%s"
+				}
+
+				resource "vault_aws_secret_backend_role" "role" {
+					backend = "${vault_aws_secret_backend.aws.path}"
+					name = "test"
+					credential_type = "federation_token"
+					policy_document = "{\"Version\": \"2012-10-17\", \"Statement\": [{\"Effect\": \"Allow\", \"Action\": \"iam:*\", \"Resource\": \"*\"}]}"
+				}
+
+				data "vault_aws_access_credentials" "test" {
+					backend = "${vault_aws_secret_backend.aws.path}"
+					role = "${vault_aws_secret_backend_role.role.name}"
+					region = "${vault_aws_secret_backend.aws.region}"
+				}`, mountPath, accessKey, secretKey, region),
+		},
 	}
 
 	for name, test := range tests {
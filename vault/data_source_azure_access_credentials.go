@@ -61,6 +61,7 @@ func azureAccessCredentialsDataSource() *schema.Resource {
 			"client_secret": {
 				Type:        schema.TypeString,
 				Computed:    true,
+				Sensitive:   true,
 				Description: "The client secret for credentials to query the Azure APIs.",
 			},
 			"lease_id": {
@@ -130,6 +131,9 @@ func azureAccessCredentialsDataSourceRead(d *schema.ResourceData, meta interface
 		return fmt.Errorf("error reading from Vault: %s", err)
 	}
 	log.Printf("[DEBUG] Read %q from Vault", configPath)
+	if secret == nil {
+		return fmt.Errorf("no Azure config found at %q", configPath)
+	}
 
 	subscriptionID := ""
 	if subscriptionIDIfc, ok := secret.Data["subscription_id"]; ok {
@@ -164,7 +168,7 @@ func azureAccessCredentialsDataSourceRead(d *schema.ResourceData, meta interface
 	}
 	authorizer, err := config.Authorizer()
 	if err != nil {
-		return nil
+		return fmt.Errorf("error creating an authorizer to validate credentials: %s", err)
 	}
 	vnetClient.Authorizer = authorizer
 
@@ -0,0 +1,115 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+const controlGroupRequestPath = "sys/control-group/request"
+
+func controlGroupRequestDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: controlGroupRequestDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"accessor": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Accessor of the wrapping token of the control group request.",
+			},
+			"approved": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the request has been approved.",
+			},
+			"request_path": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Path of the request that triggered the control group.",
+			},
+			"request_entity_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "ID of the entity that made the request.",
+			},
+			"request_entity_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Name of the entity that made the request.",
+			},
+			"authorizations": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Entities that have authorized the request.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"entity_id": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "ID of the authorizing entity.",
+						},
+						"entity_name": {
+							Type:        schema.TypeString,
+							Computed:    true,
+							Description: "Name of the authorizing entity.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func controlGroupRequestDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	accessor := d.Get("accessor").(string)
+
+	log.Printf("[DEBUG] Reading control group request %q", accessor)
+	resp, err := client.Logical().Write(controlGroupRequestPath, map[string]interface{}{
+		"accessor": accessor,
+	})
+	if err != nil {
+		return fmt.Errorf("error reading control group request %q: %s", accessor, err)
+	}
+	if resp == nil {
+		return fmt.Errorf("no control group request found for %q", accessor)
+	}
+	log.Printf("[DEBUG] Read control group request %q", accessor)
+
+	d.SetId(accessor)
+	d.Set("approved", resp.Data["approved"])
+	d.Set("request_path", resp.Data["request_path"])
+
+	if entity, ok := resp.Data["request_entity"].(map[string]interface{}); ok {
+		d.Set("request_entity_id", entity["id"])
+		d.Set("request_entity_name", entity["name"])
+	}
+
+	if err := d.Set("authorizations", controlGroupFlattenAuthorizations(resp.Data["authorizations"])); err != nil {
+		return fmt.Errorf("error setting authorizations for control group request %q: %s", accessor, err)
+	}
+
+	return nil
+}
+
+// controlGroupFlattenAuthorizations turns the authorizations Vault returns into
+// entity_id and entity_name pairs.
+func controlGroupFlattenAuthorizations(v interface{}) []map[string]interface{} {
+	raw, _ := v.([]interface{})
+	authorizations := make([]map[string]interface{}, 0, len(raw))
+	for _, r := range raw {
+		authorization, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		authorizations = append(authorizations, map[string]interface{}{
+			"entity_id":   authorization["entity_id"],
+			"entity_name": authorization["entity_name"],
+		})
+	}
+	return authorizations
+}
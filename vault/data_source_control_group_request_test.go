@@ -0,0 +1,64 @@
+package vault
+
+import (
+	"os"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestDataSourceControlGroupRequest_unknownAccessor(t *testing.T) {
+	if os.Getenv("TF_ACC_ENTERPRISE") == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: `
+data "vault_control_group_request" "test" {
+  accessor = "does-not-exist"
+}
+`,
+				ExpectError: regexp.MustCompile(`error reading control group request "does-not-exist"`),
+			},
+		},
+	})
+}
+
+func TestControlGroupFlattenAuthorizations(t *testing.T) {
+	raw := []interface{}{
+		map[string]interface{}{
+			"entity_id":   "0cb3f7a4-0b8d-4d2d-8a2d-9d4a1c4a9a55",
+			"entity_name": "alice",
+		},
+		"unexpected",
+		map[string]interface{}{
+			"entity_id":   "5f5e7d4d-3c6b-4f83-9f7a-1c8a3e0f8b21",
+			"entity_name": "bob",
+		},
+	}
+
+	expected := []map[string]interface{}{
+		{
+			"entity_id":   "0cb3f7a4-0b8d-4d2d-8a2d-9d4a1c4a9a55",
+			"entity_name": "alice",
+		},
+		{
+			"entity_id":   "5f5e7d4d-3c6b-4f83-9f7a-1c8a3e0f8b21",
+			"entity_name": "bob",
+		},
+	}
+
+	if actual := controlGroupFlattenAuthorizations(raw); !reflect.DeepEqual(actual, expected) {
+		t.Errorf("expected %v, got %v", expected, actual)
+	}
+
+	if actual := controlGroupFlattenAuthorizations(nil); len(actual) != 0 {
+		t.Errorf("expected no authorizations, got %v", actual)
+	}
+}
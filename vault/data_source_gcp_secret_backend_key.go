@@ -0,0 +1,132 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+var gcpSecretBackendCredentialSources = []string{"roleset", "static_account"}
+
+func gcpSecretBackendKeyDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: gcpSecretBackendKeyDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "GCP Secret Backend to read the key from.",
+			},
+			"roleset": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: gcpSecretBackendCredentialSources,
+				Description:  "Roleset to generate the key for. The roleset must have a secret_type of service_account_key.",
+			},
+			"static_account": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: gcpSecretBackendCredentialSources,
+				Description:  "Static account to generate the key for. The static account must have a secret_type of service_account_key.",
+			},
+			"key_algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Key algorithm used to generate the key. One of \"KEY_ALG_RSA_2048\" or \"KEY_ALG_RSA_1024\".",
+				ValidateFunc: validation.StringInSlice([]string{"KEY_ALG_RSA_2048", "KEY_ALG_RSA_1024"}, false),
+			},
+			"key_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Private key type to generate. One of \"TYPE_GOOGLE_CREDENTIALS_FILE\" or \"TYPE_PKCS12_FILE\".",
+				ValidateFunc: validation.StringInSlice([]string{"TYPE_GOOGLE_CREDENTIALS_FILE", "TYPE_PKCS12_FILE"}, false),
+			},
+			"ttl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Time-to-live of the key lease, e.g. \"1h\". Defaults to the TTL of the backend.",
+				ValidateFunc: validateDuration,
+			},
+			"private_key_data": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The base64-encoded private key of the service account.",
+			},
+			"lease_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Lease identifier assigned by vault.",
+			},
+			"lease_duration": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Lease duration in seconds relative to the time in lease_start_time.",
+			},
+			"lease_start_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time at which the lease was read, using the clock of the system where Terraform was running",
+			},
+			"lease_renewable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if the duration of this lease can be extended through renewal.",
+			},
+		},
+	}
+}
+
+func gcpSecretBackendKeyDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := gcpSecretBackendCredentialsPath(d, "key")
+
+	data := map[string]interface{}{}
+	for _, k := range []string{"key_algorithm", "key_type", "ttl"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(string)
+		}
+	}
+
+	log.Printf("[DEBUG] Generating service account key with %q", path)
+	secret, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error generating service account key with %q: %s", path, err)
+	}
+	if secret == nil {
+		return fmt.Errorf("no service account key returned from %q", path)
+	}
+	log.Printf("[DEBUG] Generated service account key with %q", path)
+
+	d.SetId(secret.LeaseID)
+	d.Set("private_key_data", secret.Data["private_key_data"])
+	d.Set("key_algorithm", secret.Data["key_algorithm"])
+	d.Set("key_type", secret.Data["key_type"])
+	d.Set("lease_id", secret.LeaseID)
+	d.Set("lease_duration", secret.LeaseDuration)
+	d.Set("lease_start_time", time.Now().Format(time.RFC3339))
+	d.Set("lease_renewable", secret.Renewable)
+
+	return nil
+}
+
+// gcpSecretBackendCredentialsPath returns the path to generate credentials of
+// the given type, "key" or "token", for the configured roleset or static
+// account.
+func gcpSecretBackendCredentialsPath(d *schema.ResourceData, credentialType string) string {
+	backend := strings.Trim(d.Get("backend").(string), "/")
+	if v, ok := d.GetOk("static_account"); ok {
+		return backend + "/static-account/" + v.(string) + "/" + credentialType
+	}
+
+	return backend + "/" + credentialType + "/" + d.Get("roleset").(string)
+}
@@ -0,0 +1,104 @@
+package vault
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+)
+
+func TestDataSourceGCPSecretBackendKey(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-gcp")
+	roleset := acctest.RandomWithPrefix("tf-test")
+	credentials, project := getTestGCPCreds(t)
+
+	config, _ := testGCPSecretRoleset_service_account_key(backend, roleset, credentials, project, "roles/viewer")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: config + `
+data "vault_gcp_secret_backend_key" "test" {
+  backend = vault_gcp_secret_backend.test.path
+  roleset = vault_gcp_secret_roleset.test.roleset
+  ttl     = "1h"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vault_gcp_secret_backend_key.test", "private_key_data"),
+					resource.TestCheckResourceAttr("data.vault_gcp_secret_backend_key.test", "key_algorithm", "KEY_ALG_RSA_2048"),
+					resource.TestCheckResourceAttr("data.vault_gcp_secret_backend_key.test", "key_type", "TYPE_GOOGLE_CREDENTIALS_FILE"),
+					resource.TestCheckResourceAttrSet("data.vault_gcp_secret_backend_key.test", "lease_id"),
+					resource.TestCheckResourceAttr("data.vault_gcp_secret_backend_key.test", "lease_duration", "3600"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceGCPSecretBackendToken(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-gcp")
+	roleset := acctest.RandomWithPrefix("tf-test")
+	credentials, project := getTestGCPCreds(t)
+
+	config, _ := testGCPSecretRoleset_access_token(backend, roleset, credentials, project, "roles/viewer")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: config + `
+data "vault_gcp_secret_backend_token" "test" {
+  backend = vault_gcp_secret_backend.test.path
+  roleset = vault_gcp_secret_roleset.test.roleset
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet("data.vault_gcp_secret_backend_token.test", "token"),
+					resource.TestCheckResourceAttrSet("data.vault_gcp_secret_backend_token.test", "expires_at_seconds"),
+					resource.TestCheckResourceAttrSet("data.vault_gcp_secret_backend_token.test", "token_ttl"),
+				),
+			},
+		},
+	})
+}
+
+func TestGCPSecretBackendCredentialsPath(t *testing.T) {
+	testCases := []struct {
+		config map[string]interface{}
+		op     string
+		want   string
+	}{
+		{
+			config: map[string]interface{}{"backend": "gcp", "roleset": "viewer"},
+			op:     "key",
+			want:   "gcp/key/viewer",
+		},
+		{
+			config: map[string]interface{}{"backend": "/gcp/", "roleset": "viewer"},
+			op:     "token",
+			want:   "gcp/token/viewer",
+		},
+		{
+			config: map[string]interface{}{"backend": "gcp", "static_account": "deploy"},
+			op:     "key",
+			want:   "gcp/static-account/deploy/key",
+		},
+		{
+			config: map[string]interface{}{"backend": "gcp", "static_account": "deploy"},
+			op:     "token",
+			want:   "gcp/static-account/deploy/token",
+		},
+	}
+
+	for _, tc := range testCases {
+		d := schema.TestResourceDataRaw(t, gcpSecretBackendKeyDataSource().Schema, tc.config)
+		if got := gcpSecretBackendCredentialsPath(d, tc.op); got != tc.want {
+			t.Errorf("expected path %q, got %q", tc.want, got)
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func gcpSecretBackendTokenDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: gcpSecretBackendTokenDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "GCP Secret Backend to read the token from.",
+			},
+			"roleset": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: gcpSecretBackendCredentialSources,
+				Description:  "Roleset to generate the token for. The roleset must have a secret_type of access_token.",
+			},
+			"static_account": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: gcpSecretBackendCredentialSources,
+				Description:  "Static account to generate the token for. The static account must have a secret_type of access_token.",
+			},
+			"token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The OAuth2 access token.",
+			},
+			"expires_at_seconds": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The time the token expires at, in seconds since the Unix epoch.",
+			},
+			"token_ttl": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The time-to-live of the token, in seconds.",
+			},
+		},
+	}
+}
+
+func gcpSecretBackendTokenDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := gcpSecretBackendCredentialsPath(d, "token")
+
+	log.Printf("[DEBUG] Generating access token with %q", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error generating access token with %q: %s", path, err)
+	}
+	if secret == nil {
+		return fmt.Errorf("no access token returned from %q", path)
+	}
+	log.Printf("[DEBUG] Generated access token with %q", path)
+
+	// Tokens aren't leased, so the path is the only stable ID.
+	d.SetId(path)
+	d.Set("token", secret.Data["token"])
+	for _, k := range []string{"expires_at_seconds", "token_ttl"} {
+		v, ok := secret.Data[k].(json.Number)
+		if !ok {
+			continue
+		}
+		n, err := v.Int64()
+		if err != nil {
+			return fmt.Errorf("expected %s from %q to be a number, got %q", k, path, v)
+		}
+		d.Set(k, n)
+	}
+
+	return nil
+}
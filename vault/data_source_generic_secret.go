@@ -29,6 +29,23 @@ func genericSecretDataSource() *schema.Resource {
 				Default:  latestSecretVersion,
 			},
 
+			"list": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				Description:   "Issue a LIST request instead of a read, returning the keys found at the path.",
+				ConflictsWith: []string{"version"},
+			},
+
+			"keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "List of keys returned from a LIST request when list is true.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
 			"data_json": {
 				Type:        schema.TypeString,
 				Computed:    true,
@@ -75,12 +92,21 @@ func genericSecretDataSourceRead(d *schema.ResourceData, meta interface{}) error
 
 	path := d.Get("path").(string)
 
-	secretVersion := d.Get("version").(int)
-	log.Printf("[DEBUG] Reading %s %d from Vault", path, secretVersion)
-
-	secret, err := versionedSecret(secretVersion, path, client)
-	if err != nil {
-		return fmt.Errorf("error reading from Vault: %s", err)
+	var secret *api.Secret
+	var err error
+	if d.Get("list").(bool) {
+		log.Printf("[DEBUG] Listing %s from Vault", path)
+		secret, err = client.Logical().List(path)
+		if err != nil {
+			return fmt.Errorf("error listing from Vault: %s", err)
+		}
+	} else {
+		secretVersion := d.Get("version").(int)
+		log.Printf("[DEBUG] Reading %s %d from Vault", path, secretVersion)
+		secret, err = versionedSecret(secretVersion, path, client)
+		if err != nil {
+			return fmt.Errorf("error reading from Vault: %s", err)
+		}
 	}
 	if secret == nil {
 		return fmt.Errorf("no secret found at %q", path)
@@ -88,6 +114,16 @@ func genericSecretDataSourceRead(d *schema.ResourceData, meta interface{}) error
 
 	d.SetId(path)
 
+	var keys []string
+	if keysI, ok := secret.Data["keys"].([]interface{}); ok && d.Get("list").(bool) {
+		for _, k := range keysI {
+			keys = append(keys, k.(string))
+		}
+	}
+	if err := d.Set("keys", keys); err != nil {
+		return err
+	}
+
 	// Ignoring error because this value came from JSON in the
 	// first place so no reason why it should fail to re-encode.
 	jsonDataBytes, _ := json.Marshal(secret.Data)
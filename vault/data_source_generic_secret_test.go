@@ -196,3 +196,44 @@ func testDataSourceGenericSecretUpdated_check(s *terraform.State) error {
 
 	return nil
 }
+
+func TestDataSourceGenericSecret_list(t *testing.T) {
+	path := acctest.RandomWithPrefix("ssh")
+	role := acctest.RandomWithPrefix("role")
+	r.Test(t, r.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []r.TestStep{
+			{
+				Config: testDataSourceGenericSecretList_config(path, role),
+				Check: r.ComposeTestCheckFunc(
+					r.TestCheckResourceAttr("data.vault_generic_secret.test", "keys.#", "1"),
+					r.TestCheckResourceAttr("data.vault_generic_secret.test", "keys.0", role),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceGenericSecretList_config(path, role string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "example" {
+  path = "%s"
+  type = "ssh"
+}
+
+resource "vault_ssh_secret_backend_role" "test_role" {
+  name                    = "%s"
+  backend                 = vault_mount.example.path
+  key_type                = "ca"
+  allow_user_certificates = true
+}
+
+data "vault_generic_secret" "test" {
+  path = "${vault_mount.example.path}/roles"
+  list = true
+
+  depends_on = [vault_ssh_secret_backend_role.test_role]
+}
+`, path, role)
+}
@@ -0,0 +1,98 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func kmipSecretCredentialsDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: kmipSecretCredentialsDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The KMIP secret backend to generate the credentials from.",
+			},
+			"scope": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the scope the role belongs to.",
+			},
+			"role": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the role to generate the credentials with.",
+			},
+			"format": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "pem",
+				Description:  "The format of the certificate and key. One of \"pem\", \"pem_bundle\" or \"der\".",
+				ValidateFunc: validation.StringInSlice([]string{"pem", "pem_bundle", "der"}, false),
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The client certificate.",
+			},
+			"private_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The private key of the client certificate.",
+			},
+			"ca_chain": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The CA chain of the client certificate.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"serial_number": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The serial number of the client certificate.",
+			},
+		},
+	}
+}
+
+func kmipSecretCredentialsDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := kmipSecretRolePath(d.Get("backend").(string), d.Get("scope").(string), d.Get("role").(string)) + "/credential/generate"
+
+	log.Printf("[DEBUG] Generating KMIP credentials with %q", path)
+	secret, err := client.Logical().Write(path, map[string]interface{}{
+		"format": d.Get("format").(string),
+	})
+	if err != nil {
+		return fmt.Errorf("error generating KMIP credentials with %q: %s", path, err)
+	}
+	if secret == nil {
+		return fmt.Errorf("no KMIP credentials returned from %q", path)
+	}
+	log.Printf("[DEBUG] Generated KMIP credentials with %q", path)
+
+	serialNumber, ok := secret.Data["serial_number"].(string)
+	if !ok || serialNumber == "" {
+		return fmt.Errorf("no serial_number returned from %q", path)
+	}
+
+	d.SetId(serialNumber)
+	d.Set("certificate", secret.Data["certificate"])
+	d.Set("private_key", secret.Data["private_key"])
+	d.Set("serial_number", serialNumber)
+	if err := d.Set("ca_chain", secret.Data["ca_chain"]); err != nil {
+		return fmt.Errorf("error setting ca_chain for %q: %s", path, err)
+	}
+
+	return nil
+}
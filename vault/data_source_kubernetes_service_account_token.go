@@ -0,0 +1,117 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func kubernetesServiceAccountTokenDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: kubernetesServiceAccountTokenDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Kubernetes secret backend to generate the token from.",
+			},
+			"role": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the role to generate the token with.",
+			},
+			"kubernetes_namespace": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Kubernetes namespace to generate the token in.",
+			},
+			"cluster_role_binding": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Bind the generated role with a ClusterRoleBinding instead of a RoleBinding, giving access across namespaces.",
+			},
+			"ttl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The TTL of the token, e.g. \"1h\". Defaults to the token_default_ttl of the role.",
+				ValidateFunc: validateDuration,
+			},
+			"service_account_name": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The name of the service account the token belongs to.",
+			},
+			"service_account_namespace": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The Kubernetes namespace of the service account.",
+			},
+			"service_account_token": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The service account token.",
+			},
+			"lease_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Lease identifier assigned by vault.",
+			},
+			"lease_duration": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Lease duration in seconds relative to the time in lease_start_time.",
+			},
+			"lease_start_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time at which the lease was read, using the clock of the system where Terraform was running",
+			},
+			"lease_renewable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if the duration of this lease can be extended through renewal.",
+			},
+		},
+	}
+}
+
+func kubernetesServiceAccountTokenDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := strings.Trim(d.Get("backend").(string), "/") + "/creds/" + d.Get("role").(string)
+
+	data := map[string]interface{}{
+		"kubernetes_namespace": d.Get("kubernetes_namespace").(string),
+		"cluster_role_binding": d.Get("cluster_role_binding").(bool),
+	}
+	if v, ok := d.GetOk("ttl"); ok {
+		data["ttl"] = v.(string)
+	}
+
+	log.Printf("[DEBUG] Generating service account token with %q", path)
+	secret, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error generating service account token with %q: %s", path, err)
+	}
+	if secret == nil {
+		return fmt.Errorf("no service account token returned from %q", path)
+	}
+	log.Printf("[DEBUG] Generated service account token with %q", path)
+
+	d.SetId(secret.LeaseID)
+	d.Set("service_account_name", secret.Data["service_account_name"])
+	d.Set("service_account_namespace", secret.Data["service_account_namespace"])
+	d.Set("service_account_token", secret.Data["service_account_token"])
+	d.Set("lease_id", secret.LeaseID)
+	d.Set("lease_duration", secret.LeaseDuration)
+	d.Set("lease_start_time", time.Now().Format(time.RFC3339))
+	d.Set("lease_renewable", secret.Renewable)
+
+	return nil
+}
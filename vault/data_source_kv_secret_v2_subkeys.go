@@ -0,0 +1,84 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func kvSecretV2SubkeysDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: kvSecretV2SubkeysDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"mount": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Path where the KV v2 secrets engine is mounted.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Path of the secret within the mount.",
+			},
+			"version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				Description:  "Version of the secret to read. If 0, the latest version is read.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"depth": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      0,
+				Description:  "Deepest nesting level to return subkeys for. If 0, all subkeys are returned.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"data_json": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "JSON-encoded subkeys of the secret, with null in place of every value.",
+			},
+		},
+	}
+}
+
+func kvSecretV2SubkeysPath(mount, name string) string {
+	return strings.Trim(mount, "/") + "/subkeys/" + strings.Trim(name, "/")
+}
+
+func kvSecretV2SubkeysDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := kvSecretV2SubkeysPath(d.Get("mount").(string), d.Get("name").(string))
+	data := map[string][]string{
+		"version": {strconv.Itoa(d.Get("version").(int))},
+		"depth":   {strconv.Itoa(d.Get("depth").(int))},
+	}
+
+	log.Printf("[DEBUG] Reading KV v2 subkeys from %q", path)
+	secret, err := client.Logical().ReadWithData(path, data)
+	if err != nil {
+		return fmt.Errorf("error reading KV v2 subkeys from %q: %s", path, err)
+	}
+	if secret == nil {
+		return fmt.Errorf("no secret found at %q", path)
+	}
+
+	subkeys, err := json.Marshal(secret.Data["subkeys"])
+	if err != nil {
+		return fmt.Errorf("error encoding KV v2 subkeys from %q: %s", path, err)
+	}
+
+	d.SetId(path)
+	d.Set("data_json", string(subkeys))
+
+	return nil
+}
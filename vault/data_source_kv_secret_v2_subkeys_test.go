@@ -0,0 +1,71 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-provider-vault/util"
+)
+
+func TestDataSourceKVSecretV2Subkeys(t *testing.T) {
+	mount := acctest.RandomWithPrefix("tf-acctest-kv")
+	name := acctest.RandomWithPrefix("foo")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceKVSecretV2SubkeysConfig(mount, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_kv_secret_v2_subkeys.all", "id", fmt.Sprintf("%s/subkeys/%s", mount, name)),
+					util.TestCheckResourceAttrJSON("data.vault_kv_secret_v2_subkeys.all", "data_json",
+						`{"zip": null, "database": {"username": null, "password": null}}`),
+					util.TestCheckResourceAttrJSON("data.vault_kv_secret_v2_subkeys.shallow", "data_json",
+						`{"zip": null, "database": null}`),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceKVSecretV2SubkeysConfig(mount, name string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "kv"
+  options = {
+    version = "2"
+  }
+}
+
+resource "vault_generic_secret" "test" {
+  path      = "${vault_mount.test.path}/%s"
+  data_json = <<EOT
+{
+  "zip": "zap",
+  "database": {
+    "username": "admin",
+    "password": "secret"
+  }
+}
+EOT
+}
+
+data "vault_kv_secret_v2_subkeys" "all" {
+  mount = vault_mount.test.path
+  name  = "%s"
+
+  depends_on = [vault_generic_secret.test]
+}
+
+data "vault_kv_secret_v2_subkeys" "shallow" {
+  mount = vault_mount.test.path
+  name  = "%s"
+  depth = 1
+
+  depends_on = [vault_generic_secret.test]
+}
+`, mount, name, name, name)
+}
@@ -0,0 +1,98 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func ldapDynamicCredentialsDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: ldapDynamicCredentialsDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The LDAP secret backend to generate the credentials from.",
+			},
+			"role": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the dynamic role to generate the credentials with.",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The username of the generated LDAP entry.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The password of the generated LDAP entry.",
+			},
+			"distinguished_names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The distinguished names of the entries created by the role's creation LDIF.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"lease_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Lease identifier assigned by vault.",
+			},
+			"lease_duration": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Lease duration in seconds relative to the time in lease_start_time.",
+			},
+			"lease_start_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time at which the lease was read, using the clock of the system where Terraform was running",
+			},
+			"lease_renewable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if the duration of this lease can be extended through renewal.",
+			},
+		},
+	}
+}
+
+func ldapDynamicCredentialsDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := strings.Trim(d.Get("backend").(string), "/") + "/creds/" + d.Get("role").(string)
+
+	log.Printf("[DEBUG] Generating LDAP credentials with %q", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error generating LDAP credentials with %q: %s", path, err)
+	}
+	if secret == nil {
+		return fmt.Errorf("no LDAP credentials returned from %q", path)
+	}
+	log.Printf("[DEBUG] Generated LDAP credentials with %q", path)
+
+	d.SetId(secret.LeaseID)
+	d.Set("username", secret.Data["username"])
+	d.Set("password", secret.Data["password"])
+	if err := d.Set("distinguished_names", secret.Data["distinguished_names"]); err != nil {
+		return fmt.Errorf("error setting distinguished_names for %q: %s", path, err)
+	}
+	d.Set("lease_id", secret.LeaseID)
+	d.Set("lease_duration", secret.LeaseDuration)
+	d.Set("lease_start_time", time.Now().Format(time.RFC3339))
+	d.Set("lease_renewable", secret.Renewable)
+
+	return nil
+}
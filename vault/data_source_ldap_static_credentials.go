@@ -0,0 +1,101 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func ldapStaticCredentialsDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: ldapStaticCredentialsDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The LDAP secret backend to read the credentials from.",
+			},
+			"role": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the static role to read the credentials of.",
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The username of the LDAP entry.",
+			},
+			"dn": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The distinguished name of the LDAP entry.",
+			},
+			"password": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The current password of the LDAP entry.",
+			},
+			"last_password": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The password the LDAP entry had before the last rotation.",
+			},
+			"last_vault_rotation": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time Vault last rotated the password.",
+			},
+			"rotation_period": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "How often Vault rotates the password, in seconds.",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of seconds until the next rotation.",
+			},
+		},
+	}
+}
+
+func ldapStaticCredentialsDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := strings.Trim(d.Get("backend").(string), "/") + "/static-cred/" + d.Get("role").(string)
+
+	log.Printf("[DEBUG] Reading LDAP static credentials %q", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading LDAP static credentials %q: %s", path, err)
+	}
+	if secret == nil {
+		return fmt.Errorf("no LDAP static credentials found at %q", path)
+	}
+	log.Printf("[DEBUG] Read LDAP static credentials %q", path)
+
+	d.SetId(path)
+	for _, k := range []string{"username", "dn", "password", "last_password", "last_vault_rotation"} {
+		d.Set(k, secret.Data[k])
+	}
+
+	for _, k := range []string{"rotation_period", "ttl"} {
+		var n int64
+		if v, ok := secret.Data[k].(json.Number); ok {
+			n, err = v.Int64()
+			if err != nil {
+				return fmt.Errorf("expected %s of %q to be a number, got %q", k, path, v)
+			}
+		}
+		d.Set(k, n)
+	}
+
+	return nil
+}
@@ -0,0 +1,97 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func leaseDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: leaseDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"lease_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The ID of the lease to look up.",
+			},
+			"expired": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if the lease no longer exists or has expired.",
+			},
+			"issue_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time at which the lease was issued.",
+			},
+			"expire_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time at which the lease expires.",
+			},
+			"last_renewal": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time of the last renewal of the lease.",
+			},
+			"renewable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if the lease can be renewed.",
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Remaining time to live of the lease in seconds.",
+			},
+		},
+	}
+}
+
+func leaseDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	leaseID := d.Get("lease_id").(string)
+
+	log.Printf("[DEBUG] Looking up lease %q", leaseID)
+	secret, err := leaseLookup(client, leaseID)
+	if err != nil {
+		return fmt.Errorf("error looking up lease %q: %s", leaseID, err)
+	}
+
+	d.SetId(leaseID)
+
+	if secret == nil {
+		log.Printf("[DEBUG] Lease %q not found or expired", leaseID)
+		d.Set("expired", true)
+		d.Set("issue_time", "")
+		d.Set("expire_time", "")
+		d.Set("last_renewal", "")
+		d.Set("renewable", false)
+		d.Set("ttl", 0)
+		return nil
+	}
+	log.Printf("[DEBUG] Looked up lease %q", leaseID)
+
+	var ttl int64
+	if v, ok := secret.Data["ttl"]; ok {
+		ttl, err = v.(json.Number).Int64()
+		if err != nil {
+			return fmt.Errorf("unexpected value %q for ttl of lease %q", v, leaseID)
+		}
+	}
+
+	d.Set("expired", false)
+	d.Set("issue_time", secret.Data["issue_time"])
+	d.Set("expire_time", secret.Data["expire_time"])
+	d.Set("last_renewal", secret.Data["last_renewal"])
+	d.Set("renewable", secret.Data["renewable"])
+	d.Set("ttl", ttl)
+
+	return nil
+}
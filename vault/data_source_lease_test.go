@@ -0,0 +1,61 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestDataSourceLease(t *testing.T) {
+	if os.Getenv(resource.TestEnvVar) == "" {
+		t.Skip(fmt.Sprintf("Acceptance tests skipped unless env '%s' set", resource.TestEnvVar))
+	}
+	testAccPreCheck(t)
+
+	client, mount, leaseID := testResourceLeaseCreateLease(t)
+	defer func() {
+		if err := client.Sys().Unmount(mount); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceLease_config(leaseID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_lease.test", "lease_id", leaseID),
+					resource.TestCheckResourceAttr("data.vault_lease.test", "expired", "false"),
+					resource.TestCheckResourceAttr("data.vault_lease.test", "renewable", "false"),
+					resource.TestCheckResourceAttrSet("data.vault_lease.test", "issue_time"),
+					resource.TestCheckResourceAttrSet("data.vault_lease.test", "expire_time"),
+					resource.TestCheckResourceAttrSet("data.vault_lease.test", "ttl"),
+				),
+			},
+			{
+				PreConfig: func() {
+					if err := client.Sys().Revoke(leaseID); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testDataSourceLease_config(leaseID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_lease.test", "expired", "true"),
+					resource.TestCheckResourceAttr("data.vault_lease.test", "ttl", "0"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceLease_config(leaseID string) string {
+	return fmt.Sprintf(`
+data "vault_lease" "test" {
+  lease_id = "%s"
+}
+`, leaseID)
+}
@@ -22,6 +22,8 @@ func TestAccDataSourceNomadAccessCredentialsClientBasic(t *testing.T) {
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttrSet("data.vault_nomad_access_token.token", "secret_id"),
 					resource.TestCheckResourceAttrSet("data.vault_nomad_access_token.token", "accessor_id"),
+					resource.TestCheckResourceAttrSet("data.vault_nomad_access_token.token", "lease_id"),
+					resource.TestCheckResourceAttr("data.vault_nomad_access_token.token", "lease_renewable", "true"),
 				),
 			},
 		},
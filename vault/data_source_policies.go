@@ -0,0 +1,116 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+// policiesDataSourceBuiltin are the ACL policies every Vault server has.
+var policiesDataSourceBuiltin = []string{"default", "root"}
+
+func policiesDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: policiesDataSourceRead,
+		Schema: map[string]*schema.Schema{
+			"prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Only list the policies whose name starts with this prefix.",
+			},
+			"include_builtin": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Include the built-in root and default policies.",
+			},
+			"read_policies": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Read the policy documents into the policies map.",
+			},
+			"names": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "Names of the ACL policies.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"policies": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Map of the policy names to their documents, set when read_policies is true.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func policiesDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	log.Printf("[DEBUG] Listing ACL policies")
+	names, err := client.Sys().ListPolicies()
+	if err != nil {
+		return fmt.Errorf("error listing ACL policies: %s", err)
+	}
+	log.Printf("[DEBUG] Listed ACL policies")
+
+	names = policiesDataSourceFilter(names, d.Get("prefix").(string), d.Get("include_builtin").(bool))
+
+	policies := map[string]string{}
+	if d.Get("read_policies").(bool) {
+		for _, name := range names {
+			log.Printf("[DEBUG] Reading ACL policy %q", name)
+			policy, err := client.Sys().GetPolicy(name)
+			if err != nil {
+				return fmt.Errorf("error reading ACL policy %q: %s", name, err)
+			}
+			policies[name] = policy
+		}
+	}
+
+	d.SetId("sys/policies/acl")
+	if err := d.Set("names", names); err != nil {
+		return fmt.Errorf("error setting names: %s", err)
+	}
+	if err := d.Set("policies", policies); err != nil {
+		return fmt.Errorf("error setting policies: %s", err)
+	}
+
+	return nil
+}
+
+// policiesDataSourceFilter returns the sorted names matching prefix, leaving
+// out the built-in policies unless includeBuiltin is set.
+func policiesDataSourceFilter(names []string, prefix string, includeBuiltin bool) []string {
+	filtered := make([]string, 0, len(names))
+	for _, name := range names {
+		if !strings.HasPrefix(name, prefix) {
+			continue
+		}
+		if !includeBuiltin && policiesDataSourceIsBuiltin(name) {
+			continue
+		}
+		filtered = append(filtered, name)
+	}
+	sort.Strings(filtered)
+	return filtered
+}
+
+func policiesDataSourceIsBuiltin(name string) bool {
+	for _, builtin := range policiesDataSourceBuiltin {
+		if name == builtin {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,111 @@
+package vault
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestDataSourcePolicies(t *testing.T) {
+	prefix := acctest.RandomWithPrefix("tf-test-policies") + "-"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourcePolicies_config(prefix, false, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_policies.test", "names.#", "2"),
+					resource.TestCheckResourceAttr("data.vault_policies.test", "names.0", prefix+"a"),
+					resource.TestCheckResourceAttr("data.vault_policies.test", "names.1", prefix+"b"),
+					resource.TestCheckResourceAttr("data.vault_policies.test", "policies.%", "0"),
+				),
+			},
+			{
+				Config: testDataSourcePolicies_config(prefix, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_policies.test", "names.#", "2"),
+					resource.TestCheckResourceAttr("data.vault_policies.test", "policies.%", "2"),
+					resource.TestCheckResourceAttrPair("data.vault_policies.test", "policies."+prefix+"a", "vault_policy.a", "policy"),
+				),
+			},
+			{
+				Config: testDataSourcePolicies_config(prefix, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_policies.test", "names.#", "2"),
+				),
+			},
+			{
+				Config: testDataSourcePolicies_builtinConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_policies.test", "names.#", "1"),
+					resource.TestCheckResourceAttr("data.vault_policies.test", "names.0", "default"),
+				),
+			},
+		},
+	})
+}
+
+func TestPoliciesDataSourceFilter(t *testing.T) {
+	names := []string{"root", "dev-b", "default", "ops", "dev-a"}
+
+	tests := []struct {
+		prefix         string
+		includeBuiltin bool
+		expected       []string
+	}{
+		{"", false, []string{"dev-a", "dev-b", "ops"}},
+		{"", true, []string{"default", "dev-a", "dev-b", "ops", "root"}},
+		{"dev-", false, []string{"dev-a", "dev-b"}},
+		{"de", true, []string{"default", "dev-a", "dev-b"}},
+		{"nope", true, []string{}},
+	}
+
+	for _, test := range tests {
+		actual := policiesDataSourceFilter(names, test.prefix, test.includeBuiltin)
+		if !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("prefix %q, include_builtin %t: expected %v, got %v", test.prefix, test.includeBuiltin, test.expected, actual)
+		}
+	}
+}
+
+var testDataSourcePolicies_builtinConfig = `
+data "vault_policies" "test" {
+  prefix          = "default"
+  include_builtin = true
+}
+`
+
+func testDataSourcePolicies_config(prefix string, readPolicies, includeBuiltin bool) string {
+	return fmt.Sprintf(`
+resource "vault_policy" "a" {
+  name   = "%[1]sa"
+  policy = <<EOT
+path "secret/a" {
+  capabilities = ["read"]
+}
+EOT
+}
+
+resource "vault_policy" "b" {
+  name   = "%[1]sb"
+  policy = <<EOT
+path "secret/b" {
+  capabilities = ["read"]
+}
+EOT
+}
+
+data "vault_policies" "test" {
+  prefix          = "%[1]s"
+  read_policies   = %[2]t
+  include_builtin = %[3]t
+
+  depends_on = [vault_policy.a, vault_policy.b]
+}
+`, prefix, readPolicies, includeBuiltin)
+}
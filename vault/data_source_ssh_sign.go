@@ -0,0 +1,131 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func sshSignDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: sshSignDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The SSH secret backend to sign the key with.",
+			},
+			"role": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the role to sign the key with.",
+			},
+			"public_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The SSH public key to sign.",
+			},
+			"cert_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "user",
+				Description:  "The type of certificate to issue. One of \"user\" or \"host\".",
+				ValidateFunc: validation.StringInSlice([]string{"user", "host"}, false),
+			},
+			"valid_principals": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The usernames or hostnames the certificate is valid for. Defaults to the role's default user.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"key_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The key ID of the certificate. Only allowed if the role allows user key IDs.",
+			},
+			"ttl": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "The TTL of the certificate, e.g. \"1h\". Defaults to the role's TTL.",
+				ValidateFunc: validateDuration,
+			},
+			"critical_options": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Critical options the certificate should be signed with.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"extensions": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Extensions the certificate should be signed with.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"signed_key": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The signed certificate, in OpenSSH format.",
+			},
+			"serial_number": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The serial number of the certificate.",
+			},
+		},
+	}
+}
+
+func sshSignDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := strings.Trim(d.Get("backend").(string), "/") + "/sign/" + d.Get("role").(string)
+
+	data := map[string]interface{}{
+		"public_key": d.Get("public_key").(string),
+		"cert_type":  d.Get("cert_type").(string),
+	}
+	if v, ok := d.GetOk("valid_principals"); ok {
+		var principals []string
+		for _, p := range v.([]interface{}) {
+			principals = append(principals, p.(string))
+		}
+		data["valid_principals"] = strings.Join(principals, ",")
+	}
+	for _, k := range []string{"key_id", "ttl", "critical_options", "extensions"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Signing SSH key with %q", path)
+	secret, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error signing SSH key with %q: %s", path, err)
+	}
+	if secret == nil {
+		return fmt.Errorf("no signed key returned from %q", path)
+	}
+	log.Printf("[DEBUG] Signed SSH key with %q", path)
+
+	serialNumber, ok := secret.Data["serial_number"].(string)
+	if !ok || serialNumber == "" {
+		return fmt.Errorf("no serial_number returned from %q", path)
+	}
+
+	d.SetId(serialNumber)
+	d.Set("signed_key", secret.Data["signed_key"])
+	d.Set("serial_number", serialNumber)
+
+	return nil
+}
@@ -0,0 +1,62 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccDataSourceSSHSign(t *testing.T) {
+	backend := "ssh-" + acctest.RandString(10)
+	dataSourceName := "data.vault_ssh_sign.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceSSHSignConfig(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr(dataSourceName, "signed_key", regexp.MustCompile("^ssh-rsa-cert-v01@openssh.com ")),
+					resource.TestCheckResourceAttrSet(dataSourceName, "serial_number"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "id", dataSourceName, "serial_number"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceSSHSignConfig(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  type = "ssh"
+  path = "%s"
+}
+
+resource "vault_ssh_secret_backend_ca" "test" {
+  backend = vault_mount.test.path
+}
+
+resource "vault_ssh_secret_backend_role" "test" {
+  name                    = "bastion"
+  backend                 = vault_mount.test.path
+  key_type                = "ca"
+  allow_user_certificates = true
+  allowed_users           = "ubuntu"
+  default_extensions      = { "permit-pty" = "" }
+  allowed_extensions      = "permit-pty"
+  depends_on              = [vault_ssh_secret_backend_ca.test]
+}
+
+data "vault_ssh_sign" "test" {
+  backend          = vault_mount.test.path
+  role             = vault_ssh_secret_backend_role.test.name
+  public_key       = %q
+  valid_principals = ["ubuntu"]
+  ttl              = "1h"
+}
+`, backend, testSSHCAPublicKey)
+}
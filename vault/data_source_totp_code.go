@@ -0,0 +1,87 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func totpCodeDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: totpCodeDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The TOTP secret backend the key belongs to.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name of the key.",
+			},
+			"validate_code": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "A code to validate against the key instead of generating one. Vault only accepts each code once.",
+			},
+			"code": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The current code of the key. Not set when validating a code.",
+			},
+			"valid": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether validate_code was accepted.",
+			},
+		},
+	}
+}
+
+func totpCodeDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := strings.Trim(d.Get("backend").(string), "/") + "/code/" + d.Get("name").(string)
+
+	if v, ok := d.GetOk("validate_code"); ok {
+		log.Printf("[DEBUG] Validating TOTP code with %q", path)
+		secret, err := client.Logical().Write(path, map[string]interface{}{
+			"code": v.(string),
+		})
+		if err != nil {
+			return fmt.Errorf("error validating TOTP code with %q: %s", path, err)
+		}
+		if secret == nil {
+			return fmt.Errorf("no validation result returned from %q", path)
+		}
+		log.Printf("[DEBUG] Validated TOTP code with %q", path)
+
+		d.SetId(path)
+		d.Set("code", "")
+		d.Set("valid", secret.Data["valid"])
+		return nil
+	}
+
+	log.Printf("[DEBUG] Generating TOTP code with %q", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error generating TOTP code with %q: %s", path, err)
+	}
+	if secret == nil {
+		return fmt.Errorf("no TOTP code returned from %q", path)
+	}
+	log.Printf("[DEBUG] Generated TOTP code with %q", path)
+
+	d.SetId(path)
+	d.Set("code", secret.Data["code"])
+	d.Set("valid", false)
+
+	return nil
+}
@@ -0,0 +1,73 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func transitBackupDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: transitBackupDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the key to back up.",
+			},
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Transit secret backend the key belongs to.",
+			},
+			"backup": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The plaintext backup of the key.",
+				Sensitive:   true,
+			},
+		},
+	}
+}
+
+func transitBackupDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := strings.Trim(d.Get("backend").(string), "/")
+	key := d.Get("key").(string)
+	path := backend + "/backup/" + key
+
+	log.Printf("[DEBUG] Backing up transit key %q", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		if msg := transitBackupErrorHint(err); msg != "" {
+			return fmt.Errorf("error backing up transit key %q: %s\n\n%s", path, err, msg)
+		}
+		return fmt.Errorf("error backing up transit key %q: %s", path, err)
+	}
+	if secret == nil {
+		return fmt.Errorf("transit key %q not found", path)
+	}
+	log.Printf("[DEBUG] Backed up transit key %q", path)
+
+	d.SetId(path)
+	d.Set("backup", secret.Data["backup"])
+
+	return nil
+}
+
+// transitBackupErrorHint translates the errors Vault returns for keys that
+// don't allow backups into a hint about the key settings to change.
+func transitBackupErrorHint(err error) string {
+	switch {
+	case strings.Contains(err.Error(), "plaintext backup is disallowed"):
+		return "the key must be created with allow_plaintext_backup set to true to be backed up"
+	case strings.Contains(err.Error(), "exporting is disallowed"):
+		return "the key must be created with exportable set to true to be backed up"
+	}
+	return ""
+}
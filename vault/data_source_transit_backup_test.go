@@ -0,0 +1,72 @@
+package vault
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestDataSourceTransitBackup(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testDataSourceTransitBackup_config(backend, false),
+				ExpectError: regexp.MustCompile("the key must be created with allow_plaintext_backup set to true to be backed up"),
+			},
+			{
+				Config: testDataSourceTransitBackup_config(backend, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.vault_transit_backup.test", "id", backend+"/backup/test"),
+					resource.TestCheckResourceAttrSet("data.vault_transit_backup.test", "backup"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceTransitBackup_config(backend string, allowBackup bool) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  name                   = "test"
+  backend                = vault_mount.test.path
+  deletion_allowed       = true
+  exportable             = true
+  allow_plaintext_backup = %t
+}
+
+data "vault_transit_backup" "test" {
+  backend = vault_mount.test.path
+  key     = vault_transit_secret_backend_key.test.name
+}
+`, backend, allowBackup)
+}
+
+func TestTransitBackupErrorHint(t *testing.T) {
+	testCases := map[string]string{
+		"Code: 400. Errors:\n\n* plaintext backup is disallowed on the policy": "allow_plaintext_backup",
+		"Code: 400. Errors:\n\n* exporting is disallowed on the policy":        "exportable",
+		"Code: 403. Errors:\n\n* permission denied":                            "",
+	}
+
+	for msg, expected := range testCases {
+		hint := transitBackupErrorHint(errors.New(msg))
+		if expected == "" && hint != "" {
+			t.Fatalf("expected no hint for %q, got %q", msg, hint)
+		}
+		if !regexp.MustCompile(expected).MatchString(hint) {
+			t.Fatalf("expected hint for %q to mention %q, got %q", msg, expected, hint)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package vault
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func transitDatakeyDataSource() *schema.Resource {
+	return &schema.Resource{
+		Read: transitDatakeyDataSourceRead,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The Transit secret backend the key belongs to.",
+			},
+			"key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Name of the encryption key used to wrap the data key.",
+			},
+			"key_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Whether the plaintext data key is returned along with the ciphertext. One of \"plaintext\" or \"wrapped\".",
+				ValidateFunc: validation.StringInSlice([]string{"plaintext", "wrapped"}, false),
+			},
+			"bits": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      256,
+				Description:  "Number of bits in the generated data key. One of 128, 256 or 512.",
+				ValidateFunc: validation.IntInSlice([]int{128, 256, 512}),
+			},
+			"context": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Context for key derivation. Required if key derivation is enabled for the key.",
+			},
+			"ciphertext": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The data key encrypted with the named key.",
+			},
+			"plaintext": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The base64 encoded data key. Only set when key_type is \"plaintext\".",
+			},
+		},
+	}
+}
+
+func transitDatakeyDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	key := d.Get("key").(string)
+	keyType := d.Get("key_type").(string)
+	context := d.Get("context").(string)
+
+	keyPath := transitSecretBackendKeyPath(backend, key)
+	log.Printf("[DEBUG] Reading transit key %q", keyPath)
+	keyResp, err := client.Logical().Read(keyPath)
+	if err != nil {
+		return fmt.Errorf("error reading transit key %q: %s", keyPath, err)
+	}
+	if keyResp == nil {
+		return fmt.Errorf("transit key %q not found", keyPath)
+	}
+	if derived, _ := keyResp.Data["derived"].(bool); derived && context == "" {
+		return fmt.Errorf("transit key %q has key derivation enabled, context is required", keyPath)
+	}
+
+	payload := map[string]interface{}{
+		"bits": d.Get("bits").(int),
+	}
+	if context != "" {
+		payload["context"] = base64.StdEncoding.EncodeToString([]byte(context))
+	}
+
+	path := strings.Trim(backend, "/") + "/datakey/" + keyType + "/" + strings.Trim(key, "/")
+	log.Printf("[DEBUG] Generating data key with %q", path)
+	resp, err := client.Logical().Write(path, payload)
+	if err != nil {
+		return fmt.Errorf("error generating data key with %q: %s", path, err)
+	}
+	if resp == nil {
+		return fmt.Errorf("no data key returned from %q", path)
+	}
+	log.Printf("[DEBUG] Generated data key with %q", path)
+
+	cipherText, ok := resp.Data["ciphertext"].(string)
+	if !ok {
+		return fmt.Errorf("expected ciphertext from %q to be a string, and it isn't", path)
+	}
+
+	d.SetId(base64.StdEncoding.EncodeToString([]byte(cipherText)))
+	d.Set("ciphertext", cipherText)
+	if keyType == "plaintext" {
+		d.Set("plaintext", resp.Data["plaintext"])
+	} else {
+		d.Set("plaintext", "")
+	}
+
+	return nil
+}
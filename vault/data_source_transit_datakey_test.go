@@ -0,0 +1,93 @@
+package vault
+
+import (
+	"encoding/base64"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestDataSourceTransitDatakey(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceTransitDatakeyConfig(backend, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.vault_transit_datakey.plaintext", "ciphertext", regexp.MustCompile(`^vault:v1:`)),
+					testDataSourceTransitDatakeyCheckBits("data.vault_transit_datakey.plaintext", 512),
+					resource.TestMatchResourceAttr("data.vault_transit_datakey.wrapped", "ciphertext", regexp.MustCompile(`^vault:v1:`)),
+					resource.TestCheckResourceAttr("data.vault_transit_datakey.wrapped", "plaintext", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceTransitDatakey_derived(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testDataSourceTransitDatakeyConfig(backend, true),
+				ExpectError: regexp.MustCompile("context is required"),
+			},
+		},
+	})
+}
+
+func testDataSourceTransitDatakeyCheckBits(name string, bits int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("%q not found in state", name)
+		}
+
+		key, err := base64.StdEncoding.DecodeString(rs.Primary.Attributes["plaintext"])
+		if err != nil {
+			return fmt.Errorf("expected plaintext to be base64 encoded: %s", err)
+		}
+		if len(key)*8 != bits {
+			return fmt.Errorf("expected a %d bit data key, got %d bits", bits, len(key)*8)
+		}
+
+		return nil
+	}
+}
+
+func testDataSourceTransitDatakeyConfig(backend string, derived bool) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  name             = "test"
+  backend          = vault_mount.test.path
+  derived          = %t
+  deletion_allowed = true
+}
+
+data "vault_transit_datakey" "plaintext" {
+  backend  = vault_mount.test.path
+  key      = vault_transit_secret_backend_key.test.name
+  key_type = "plaintext"
+  bits     = 512
+}
+
+data "vault_transit_datakey" "wrapped" {
+  backend  = vault_mount.test.path
+  key      = vault_transit_secret_backend_key.test.name
+  key_type = "wrapped"
+}
+`, backend, derived)
+}
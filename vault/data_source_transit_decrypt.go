@@ -3,6 +3,7 @@ package vault
 import (
 	"encoding/base64"
 	"fmt"
+
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/vault/api"
 )
@@ -49,18 +50,25 @@ func transitDecryptDataSourceRead(d *schema.ResourceData, meta interface{}) erro
 	key := d.Get("key").(string)
 	ciphertext := d.Get("ciphertext").(string)
 
-	context := base64.StdEncoding.EncodeToString([]byte(d.Get("context").(string)))
 	payload := map[string]interface{}{
 		"ciphertext": ciphertext,
-		"context":    context,
+	}
+	if v, ok := d.GetOk("context"); ok {
+		payload["context"] = base64.StdEncoding.EncodeToString([]byte(v.(string)))
 	}
 
 	decryptedData, err := client.Logical().Write(backend+"/decrypt/"+key, payload)
 	if err != nil {
-		return fmt.Errorf("issue encrypting with key: %s", err)
+		return fmt.Errorf("issue decrypting with key: %s", err)
+	}
+	if decryptedData == nil {
+		return fmt.Errorf("no plaintext returned when decrypting with key %q", key)
 	}
 
-	plaintext, _ := base64.StdEncoding.DecodeString(decryptedData.Data["plaintext"].(string))
+	plaintext, err := base64.StdEncoding.DecodeString(decryptedData.Data["plaintext"].(string))
+	if err != nil {
+		return fmt.Errorf("error decoding plaintext decrypted with key %q: %s", key, err)
+	}
 
 	d.SetId(base64.StdEncoding.EncodeToString([]byte(ciphertext)))
 	d.Set("plaintext", string(plaintext))
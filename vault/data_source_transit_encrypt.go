@@ -3,8 +3,9 @@ package vault
 import (
 	"encoding/base64"
 	"fmt"
-	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/vault/api"
 )
 
@@ -26,7 +27,7 @@ func transitEncryptDataSource() *schema.Resource {
 			"plaintext": {
 				Type:        schema.TypeString,
 				Required:    true,
-				Description: "Map of strings read from Vault.",
+				Description: "Plaintext to be encrypted.",
 				Sensitive:   true,
 			},
 			"context": {
@@ -35,9 +36,10 @@ func transitEncryptDataSource() *schema.Resource {
 				Description: "Specifies the context for key derivation",
 			},
 			"key_version": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Description: "The version of the key to use for encryption",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "The version of the key to use for encryption. If not set, the latest version is used.",
+				ValidateFunc: validation.IntAtLeast(1),
 			},
 			"ciphertext": {
 				Type:        schema.TypeString,
@@ -53,24 +55,28 @@ func transitEncryptDataSourceRead(d *schema.ResourceData, meta interface{}) erro
 
 	backend := d.Get("backend").(string)
 	key := d.Get("key").(string)
-	keyVersion := d.Get("key_version").(int)
 
-	plaintext := base64.StdEncoding.EncodeToString([]byte(d.Get("plaintext").(string)))
-	context := base64.StdEncoding.EncodeToString([]byte(d.Get("context").(string)))
 	payload := map[string]interface{}{
-		"plaintext":   plaintext,
-		"context":     context,
-		"key_version": keyVersion,
+		"plaintext": base64.StdEncoding.EncodeToString([]byte(d.Get("plaintext").(string))),
+	}
+	if v, ok := d.GetOk("context"); ok {
+		payload["context"] = base64.StdEncoding.EncodeToString([]byte(v.(string)))
+	}
+	if v, ok := d.GetOk("key_version"); ok {
+		payload["key_version"] = v.(int)
 	}
 
 	encryptedData, err := client.Logical().Write(backend+"/encrypt/"+key, payload)
 	if err != nil {
 		return fmt.Errorf("issue encrypting with key: %s", err)
 	}
+	if encryptedData == nil {
+		return fmt.Errorf("no ciphertext returned when encrypting with key %q", key)
+	}
 
-	cipherText := encryptedData.Data["ciphertext"]
+	cipherText := encryptedData.Data["ciphertext"].(string)
 
-	d.SetId(base64.StdEncoding.EncodeToString([]byte(cipherText.(string))))
+	d.SetId(base64.StdEncoding.EncodeToString([]byte(cipherText)))
 	d.Set("ciphertext", cipherText)
 
 	return nil
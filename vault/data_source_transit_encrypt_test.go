@@ -2,8 +2,10 @@ package vault
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 )
@@ -22,6 +24,54 @@ func TestDataSourceTransitEncrypt(t *testing.T) {
 
 }
 
+func TestDataSourceTransitEncrypt_derived(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceTransitEncryptConfig_derived(backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.vault_transit_encrypt.test", "ciphertext", regexp.MustCompile(`^vault:v1:`)),
+					resource.TestCheckResourceAttr("data.vault_transit_decrypt.test", "plaintext", "foo"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceTransitEncryptConfig_derived(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  name             = "test"
+  backend          = vault_mount.test.path
+  derived          = true
+  deletion_allowed = true
+}
+
+data "vault_transit_encrypt" "test" {
+  backend     = vault_mount.test.path
+  key         = vault_transit_secret_backend_key.test.name
+  plaintext   = "foo"
+  context     = "my-context"
+  key_version = 1
+}
+
+data "vault_transit_decrypt" "test" {
+  backend    = vault_mount.test.path
+  key        = vault_transit_secret_backend_key.test.name
+  ciphertext = data.vault_transit_encrypt.test.ciphertext
+  context    = "my-context"
+}
+`, backend)
+}
+
 var testDataSourceTransitEncrypt_config = `
 resource "vault_mount" "test" {
   path        = "transit"
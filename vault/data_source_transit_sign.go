@@ -0,0 +1,139 @@
+package vault
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+// transitSignatureSchema returns the arguments shared by the sign and verify
+// data sources. Both endpoints must be given the same options for a signature
+// to verify.
+func transitSignatureSchema() map[string]*schema.Schema {
+	return map[string]*schema.Schema{
+		"backend": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The Transit secret backend the key belongs to.",
+		},
+		"key": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "Name of the signing key to use.",
+		},
+		"input": {
+			Type:         schema.TypeString,
+			Required:     true,
+			Description:  "The base64 encoded input data.",
+			ValidateFunc: validation.StringIsBase64,
+		},
+		"context": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Context for key derivation. Required if key derivation is enabled for the key.",
+		},
+		"hash_algorithm": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The hash algorithm to use. Ignored for ed25519 keys.",
+			ValidateFunc: validation.StringInSlice([]string{
+				"sha1", "sha2-224", "sha2-256", "sha2-384", "sha2-512",
+				"sha3-224", "sha3-256", "sha3-384", "sha3-512", "none",
+			}, false),
+		},
+		"signature_algorithm": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "The signature algorithm to use for RSA keys. One of \"pss\" or \"pkcs1v15\".",
+			ValidateFunc: validation.StringInSlice([]string{"pss", "pkcs1v15"}, false),
+		},
+		"prehashed": {
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: "Set to true when the input is already hashed.",
+		},
+		"marshaling_algorithm": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "The way the signature is marshaled for ECDSA keys. One of \"asn1\" or \"jws\".",
+			ValidateFunc: validation.StringInSlice([]string{"asn1", "jws"}, false),
+		},
+	}
+}
+
+func transitSignDataSource() *schema.Resource {
+	s := transitSignatureSchema()
+	s["key_version"] = &schema.Schema{
+		Type:         schema.TypeInt,
+		Optional:     true,
+		Description:  "The version of the key to sign with. If not set, the latest version is used.",
+		ValidateFunc: validation.IntAtLeast(1),
+	}
+	s["signature"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Computed:    true,
+		Description: "The signature of the input.",
+	}
+
+	return &schema.Resource{
+		Read:   transitSignDataSourceRead,
+		Schema: s,
+	}
+}
+
+func transitSignDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := transitSignaturePath(d, "sign")
+
+	data := transitSignatureRequestData(d)
+	if v, ok := d.GetOk("key_version"); ok {
+		data["key_version"] = v.(int)
+	}
+
+	log.Printf("[DEBUG] Signing with %q", path)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error signing with %q: %s", path, err)
+	}
+	if resp == nil {
+		return fmt.Errorf("no signature returned from %q", path)
+	}
+	log.Printf("[DEBUG] Signed with %q", path)
+
+	signature, ok := resp.Data["signature"].(string)
+	if !ok {
+		return fmt.Errorf("expected signature from %q to be a string, and it isn't", path)
+	}
+
+	d.SetId(base64.StdEncoding.EncodeToString([]byte(signature)))
+	d.Set("signature", signature)
+
+	return nil
+}
+
+func transitSignaturePath(d *schema.ResourceData, op string) string {
+	return strings.Trim(d.Get("backend").(string), "/") + "/" + op + "/" + strings.Trim(d.Get("key").(string), "/")
+}
+
+func transitSignatureRequestData(d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"input":     d.Get("input").(string),
+		"prehashed": d.Get("prehashed").(bool),
+	}
+	if v, ok := d.GetOk("context"); ok {
+		data["context"] = base64.StdEncoding.EncodeToString([]byte(v.(string)))
+	}
+	for _, k := range []string{"hash_algorithm", "signature_algorithm", "marshaling_algorithm"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(string)
+		}
+	}
+
+	return data
+}
@@ -0,0 +1,89 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestDataSourceTransitSign_ed25519(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceTransitSignConfig(backend, "ed25519", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.vault_transit_sign.test", "signature", regexp.MustCompile(`^vault:v1:`)),
+					resource.TestCheckResourceAttr("data.vault_transit_verify.valid", "valid", "true"),
+					resource.TestCheckResourceAttr("data.vault_transit_verify.invalid", "valid", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestDataSourceTransitSign_rsa(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+	options := `
+  hash_algorithm      = "sha2-512"
+  signature_algorithm = "pkcs1v15"
+`
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testDataSourceTransitSignConfig(backend, "rsa-2048", options),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.vault_transit_sign.test", "signature", regexp.MustCompile(`^vault:v1:`)),
+					resource.TestCheckResourceAttr("data.vault_transit_verify.valid", "valid", "true"),
+					resource.TestCheckResourceAttr("data.vault_transit_verify.invalid", "valid", "false"),
+				),
+			},
+		},
+	})
+}
+
+func testDataSourceTransitSignConfig(backend, keyType, options string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  name             = "test"
+  backend          = vault_mount.test.path
+  type             = "%s"
+  deletion_allowed = true
+}
+
+data "vault_transit_sign" "test" {
+  backend = vault_mount.test.path
+  key     = vault_transit_secret_backend_key.test.name
+  input   = base64encode("artifact")
+%[3]s
+}
+
+data "vault_transit_verify" "valid" {
+  backend   = vault_mount.test.path
+  key       = vault_transit_secret_backend_key.test.name
+  input     = base64encode("artifact")
+  signature = data.vault_transit_sign.test.signature
+%[3]s
+}
+
+data "vault_transit_verify" "invalid" {
+  backend   = vault_mount.test.path
+  key       = vault_transit_secret_backend_key.test.name
+  input     = base64encode("tampered")
+  signature = data.vault_transit_sign.test.signature
+%[3]s
+}
+`, backend, keyType, options)
+}
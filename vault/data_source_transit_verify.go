@@ -0,0 +1,58 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func transitVerifyDataSource() *schema.Resource {
+	s := transitSignatureSchema()
+	s["signature"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Required:    true,
+		Description: "The signature to verify, as returned by Vault.",
+	}
+	s["valid"] = &schema.Schema{
+		Type:        schema.TypeBool,
+		Computed:    true,
+		Description: "Whether the signature is valid for the input.",
+	}
+
+	return &schema.Resource{
+		Read:   transitVerifyDataSourceRead,
+		Schema: s,
+	}
+}
+
+func transitVerifyDataSourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := transitSignaturePath(d, "verify")
+
+	data := transitSignatureRequestData(d)
+	data["signature"] = d.Get("signature").(string)
+
+	log.Printf("[DEBUG] Verifying signature with %q", path)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error verifying signature with %q: %s", path, err)
+	}
+	if resp == nil {
+		return fmt.Errorf("no verification result returned from %q", path)
+	}
+	log.Printf("[DEBUG] Verified signature with %q", path)
+
+	valid, ok := resp.Data["valid"].(bool)
+	if !ok {
+		return fmt.Errorf("expected valid from %q to be a bool, and it isn't", path)
+	}
+
+	d.SetId(path + ":" + strconv.FormatBool(valid))
+	d.Set("valid", valid)
+
+	return nil
+}
@@ -22,6 +22,8 @@ func TestAccAuthBackend_importBasic(t *testing.T) {
 				ResourceName:      "vault_auth_backend.test",
 				ImportState:       true,
 				ImportStateVerify: true,
+				// force_delete only affects destroy and isn't stored in Vault.
+				ImportStateVerifyIgnore: []string{"force_delete"},
 			},
 		},
 	})
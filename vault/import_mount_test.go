@@ -26,6 +26,8 @@ func TestAccMount_importBasic(t *testing.T) {
 				ResourceName:      "vault_mount.test",
 				ImportState:       true,
 				ImportStateVerify: true,
+				// force_delete only affects destroy and isn't stored in Vault.
+				ImportStateVerifyIgnore: []string{"force_delete"},
 			},
 		},
 	})
@@ -21,6 +21,9 @@ func TestAccPolicy_importBasic(t *testing.T) {
 				ResourceName:      "vault_policy.test",
 				ImportState:       true,
 				ImportStateVerify: true,
+				// validate_templating is only used at plan time and isn't
+				// stored in Vault.
+				ImportStateVerifyIgnore: []string{"validate_templating"},
 			},
 		},
 	})
@@ -5,10 +5,66 @@ import (
 	"io"
 	"path"
 	"strings"
+	"sync"
 
 	"github.com/hashicorp/vault/api"
+	"github.com/hashicorp/vault/sdk/helper/consts"
 )
 
+// kvMounts caches the KV version of the mounts found by isKVv2, so secrets
+// don't look up their mount on every request. vault_mount resets it whenever
+// it changes a mount, e.g. upgrading it to KV v2.
+var kvMounts = &kvMountCache{}
+
+// kvMountCache holds the KV version of mounts by the address and namespace of
+// the client, then by mount path.
+type kvMountCache struct {
+	sync.Mutex
+	versions map[string]map[string]int
+}
+
+func kvMountCacheKey(client *api.Client) string {
+	return client.Address() + "|" + client.Headers().Get(consts.NamespaceHeaderName)
+}
+
+// get returns the mount of path and its version, if cached. Mounts may be
+// nested, so the longest matching mount path wins.
+func (c *kvMountCache) get(client *api.Client, path string) (string, int, bool) {
+	c.Lock()
+	defer c.Unlock()
+
+	var mountPath string
+	var version int
+	for p, v := range c.versions[kvMountCacheKey(client)] {
+		if strings.HasPrefix(path+"/", p) && len(p) > len(mountPath) {
+			mountPath, version = p, v
+		}
+	}
+
+	return mountPath, version, mountPath != ""
+}
+
+func (c *kvMountCache) set(client *api.Client, mountPath string, version int) {
+	c.Lock()
+	defer c.Unlock()
+
+	if c.versions == nil {
+		c.versions = make(map[string]map[string]int)
+	}
+	key := kvMountCacheKey(client)
+	if c.versions[key] == nil {
+		c.versions[key] = make(map[string]int)
+	}
+	c.versions[key][mountPath] = version
+}
+
+func (c *kvMountCache) reset() {
+	c.Lock()
+	defer c.Unlock()
+
+	c.versions = nil
+}
+
 func versionedSecret(requestedVersion int, path string, client *api.Client) (*api.Secret, error) {
 	mountPath, v2, err := isKVv2(path, client)
 	if err != nil {
@@ -78,6 +134,21 @@ func kvReadRequest(client *api.Client, path string, params map[string]string) (*
 	return api.ParseSecret(resp.Body)
 }
 
+// kvPatchRequest merges data into the KV v2 secret at path using a JSON merge
+// patch, which requires Vault 1.9 or later. Keys set to nil are removed.
+func kvPatchRequest(client *api.Client, path string, data map[string]interface{}) error {
+	r := client.NewRequest("PATCH", "/v1/"+path)
+	r.Headers.Set("Content-Type", "application/merge-patch+json")
+	if err := r.SetJSONBody(data); err != nil {
+		return err
+	}
+	resp, err := client.RawRequest(r)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	return err
+}
+
 func kvPreflightVersionRequest(client *api.Client, path string) (string, int, error) {
 	// We don't want to use a wrapping call here so save any custom value and
 	// restore after
@@ -128,10 +199,19 @@ func kvPreflightVersionRequest(client *api.Client, path string) (string, int, er
 }
 
 func isKVv2(path string, client *api.Client) (string, bool, error) {
+	if mountPath, version, ok := kvMounts.get(client, path); ok {
+		return mountPath, version == 2, nil
+	}
+
 	mountPath, version, err := kvPreflightVersionRequest(client, path)
 	if err != nil {
 		return "", false, err
 	}
+	// Older versions of Vault don't return the mount, there's nothing to
+	// cache it by.
+	if mountPath != "" {
+		kvMounts.set(client, mountPath, version)
+	}
 
 	return mountPath, version == 2, nil
 }
@@ -0,0 +1,71 @@
+package vault
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/hashicorp/vault/api"
+)
+
+func TestIsKVv2_cache(t *testing.T) {
+	defer kvMounts.reset()
+
+	var requests int
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		requests++
+		json.NewEncoder(w).Encode(map[string]interface{}{
+			"data": map[string]interface{}{
+				"path": "secret/",
+				"options": map[string]interface{}{
+					"version": "2",
+				},
+			},
+		})
+	}))
+	defer server.Close()
+
+	config := api.DefaultConfig()
+	config.Address = server.URL
+	client, err := api.NewClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	isKVv2Requests := func(path string, client *api.Client) int {
+		before := requests
+		mountPath, v2, err := isKVv2(path, client)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if mountPath != "secret/" || !v2 {
+			t.Fatalf("expected KV v2 mount %q, got %q, v2: %t", "secret/", mountPath, v2)
+		}
+		return requests - before
+	}
+
+	if n := isKVv2Requests("secret/foo", client); n != 1 {
+		t.Fatalf("expected the mount to be looked up, got %d requests", n)
+	}
+	if n := isKVv2Requests("secret/bar/baz", client); n != 0 {
+		t.Fatalf("expected the mount to be cached, got %d requests", n)
+	}
+	if n := isKVv2Requests("secret", client); n != 0 {
+		t.Fatalf("expected the mount to be cached, got %d requests", n)
+	}
+
+	nsClient, err := cloneClient(client)
+	if err != nil {
+		t.Fatal(err)
+	}
+	nsClient.SetNamespace("ns1")
+	if n := isKVv2Requests("secret/foo", nsClient); n != 1 {
+		t.Fatalf("expected the mount to be looked up in the namespace, got %d requests", n)
+	}
+
+	kvMounts.reset()
+	if n := isKVv2Requests("secret/foo", client); n != 1 {
+		t.Fatalf("expected the mount to be looked up after a reset, got %d requests", n)
+	}
+}
@@ -7,11 +7,13 @@ import (
 	"net/http"
 	"os"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/logging"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/mutexkv"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/vault/api"
 	awsauth "github.com/hashicorp/vault/builtin/credential/aws"
 	"github.com/hashicorp/vault/command/config"
@@ -149,6 +151,12 @@ func Provider() *schema.Provider {
 
 				Description: "Maximum TTL for secret leases requested by this provider",
 			},
+			"skip_child_token": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				DefaultFunc: schema.EnvDefaultFunc("TERRAFORM_VAULT_SKIP_CHILD_TOKEN", false),
+				Description: "Set this to true to prevent the creation of ephemeral child token used by this provider.",
+			},
 			"max_retries": {
 				Type:     schema.TypeInt,
 				Optional: true,
@@ -156,6 +164,19 @@ func Provider() *schema.Provider {
 				DefaultFunc: schema.EnvDefaultFunc("VAULT_MAX_RETRIES", 2),
 				Description: "Maximum number of retries when a 5xx error code is encountered.",
 			},
+			"client_timeout": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+				Description:  "Timeout in seconds for each request to Vault. Resources with a longer running operation use their own timeouts instead.",
+			},
+			"max_idle_connections": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				DefaultFunc:  schema.EnvDefaultFunc("TERRAFORM_VAULT_MAX_IDLE_CONNECTIONS", 0),
+				ValidateFunc: validation.IntAtLeast(0),
+				Description:  "Maximum number of idle connections to Vault kept for reuse.",
+			},
 			"namespace": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -224,6 +245,10 @@ var (
 			Resource:      identityGroupDataSource(),
 			PathInventory: []string{"/identity/lookup/group"},
 		},
+		"vault_kmip_secret_credentials": {
+			Resource:      kmipSecretCredentialsDataSource(),
+			PathInventory: []string{"/kmip/scope/{scope}/role/{role}/credential/generate"},
+		},
 		"vault_kubernetes_auth_backend_config": {
 			Resource:      kubernetesAuthBackendConfigDataSource(),
 			PathInventory: []string{"/auth/kubernetes/config"},
@@ -232,6 +257,22 @@ var (
 			Resource:      kubernetesAuthBackendRoleDataSource(),
 			PathInventory: []string{"/auth/kubernetes/role/{name}"},
 		},
+		"vault_kubernetes_service_account_token": {
+			Resource:      kubernetesServiceAccountTokenDataSource(),
+			PathInventory: []string{"/kubernetes/creds/{role}"},
+		},
+		"vault_kv_secret_v2_subkeys": {
+			Resource:      kvSecretV2SubkeysDataSource(),
+			PathInventory: []string{"/secret/subkeys/{path}"},
+		},
+		"vault_ldap_dynamic_credentials": {
+			Resource:      ldapDynamicCredentialsDataSource(),
+			PathInventory: []string{"/ldap/creds/{role}"},
+		},
+		"vault_ldap_static_credentials": {
+			Resource:      ldapStaticCredentialsDataSource(),
+			PathInventory: []string{"/ldap/static-cred/{name}"},
+		},
 		"vault_ad_access_credentials": {
 			Resource:      adAccessCredentialsDataSource(),
 			PathInventory: []string{"/ad/creds/{role}"},
@@ -252,6 +293,14 @@ var (
 			Resource:      genericSecretDataSource(),
 			PathInventory: []string{"/secret/data/{path}"},
 		},
+		"vault_lease": {
+			Resource:      leaseDataSource(),
+			PathInventory: []string{"/sys/leases/lookup"},
+		},
+		"vault_policies": {
+			Resource:      policiesDataSource(),
+			PathInventory: []string{"/sys/policies/acl", "/sys/policies/acl/{name}"},
+		},
 		"vault_policy_document": {
 			Resource:      policyDocumentDataSource(),
 			PathInventory: []string{"/sys/policy/{name}"},
@@ -260,18 +309,54 @@ var (
 			Resource:      authBackendDataSource(),
 			PathInventory: []string{"/sys/auth"},
 		},
+		"vault_control_group_request": {
+			Resource:      controlGroupRequestDataSource(),
+			PathInventory: []string{"/sys/control-group/request"},
+		},
+		"vault_ssh_sign": {
+			Resource:      sshSignDataSource(),
+			PathInventory: []string{"/ssh/sign/{role}"},
+		},
+		"vault_totp_code": {
+			Resource:      totpCodeDataSource(),
+			PathInventory: []string{"/totp/code/{name}"},
+		},
 		"vault_transit_encrypt": {
 			Resource:      transitEncryptDataSource(),
 			PathInventory: []string{"/transit/encrypt/{name}"},
 		},
+		"vault_transit_datakey": {
+			Resource:      transitDatakeyDataSource(),
+			PathInventory: []string{"/transit/datakey/{plaintext}/{name}"},
+		},
 		"vault_transit_decrypt": {
 			Resource:      transitDecryptDataSource(),
 			PathInventory: []string{"/transit/decrypt/{name}"},
 		},
+		"vault_transit_sign": {
+			Resource:      transitSignDataSource(),
+			PathInventory: []string{"/transit/sign/{name}"},
+		},
+		"vault_transit_verify": {
+			Resource:      transitVerifyDataSource(),
+			PathInventory: []string{"/transit/verify/{name}"},
+		},
+		"vault_transit_backup": {
+			Resource:      transitBackupDataSource(),
+			PathInventory: []string{"/transit/backup/{name}"},
+		},
 		"vault_gcp_auth_backend_role": {
 			Resource:      gcpAuthBackendRoleDataSource(),
 			PathInventory: []string{"/auth/gcp/role/{role_name}"},
 		},
+		"vault_gcp_secret_backend_key": {
+			Resource:      gcpSecretBackendKeyDataSource(),
+			PathInventory: []string{"/gcp/key/{roleset}", "/gcp/static-account/{name}/key"},
+		},
+		"vault_gcp_secret_backend_token": {
+			Resource:      gcpSecretBackendTokenDataSource(),
+			PathInventory: []string{"/gcp/token/{roleset}", "/gcp/static-account/{name}/token"},
+		},
 	}
 
 	ResourceRegistry = map[string]*Description{
@@ -299,6 +384,14 @@ var (
 			Resource:      AuthBackendResource(),
 			PathInventory: []string{"/sys/auth/{path}"},
 		},
+		"vault_auth_backend_mfa": {
+			Resource: authBackendMFAResource(),
+			PathInventory: []string{
+				"/auth/{path}/mfa_config",
+				"/auth/{path}/duo/access",
+				"/auth/{path}/duo/config",
+			},
+		},
 		"vault_token": {
 			Resource: tokenResource(),
 			PathInventory: []string{
@@ -379,6 +472,14 @@ var (
 			Resource:      azureAuthBackendRoleResource(),
 			PathInventory: []string{"/auth/azure/role/{name}"},
 		},
+		"vault_config_ui_custom_message": {
+			Resource:      configUICustomMessageResource(),
+			PathInventory: []string{"/sys/config/ui/custom-messages", "/sys/config/ui/custom-messages/{id}"},
+		},
+		"vault_config_ui_header": {
+			Resource:      configUIHeaderResource(),
+			PathInventory: []string{"/sys/config/ui/headers/{name}"},
+		},
 		"vault_consul_secret_backend": {
 			Resource:      consulSecretBackendResource(),
 			PathInventory: []string{"/consul/config/access"},
@@ -387,9 +488,17 @@ var (
 			Resource:      consulSecretBackendRoleResource(),
 			PathInventory: []string{"/consul/roles/{name}"},
 		},
+		"vault_control_group_authorization": {
+			Resource:      controlGroupAuthorizationResource(),
+			PathInventory: []string{"/sys/control-group/authorize"},
+		},
+		"vault_cors_config": {
+			Resource:      corsConfigResource(),
+			PathInventory: []string{"/sys/config/cors"},
+		},
 		"vault_database_secret_backend_connection": {
 			Resource:      databaseSecretBackendConnectionResource(),
-			PathInventory: []string{"/database/config/{name}"},
+			PathInventory: []string{"/database/config/{name}", "/database/rotate-root/{name}"},
 		},
 		"vault_database_secret_backend_role": {
 			Resource:      databaseSecretBackendRoleResource(),
@@ -399,6 +508,10 @@ var (
 			Resource:      databaseSecretBackendStaticRoleResource(),
 			PathInventory: []string{"/database/static-roles/{name}"},
 		},
+		"vault_database_secret_backend_static_role_rotation": {
+			Resource:      databaseSecretBackendStaticRoleRotationResource(),
+			PathInventory: []string{"/database/rotate-role/{name}"},
+		},
 		"vault_github_auth_backend": {
 			Resource:      githubAuthBackendResource(),
 			PathInventory: []string{"/auth/github/config"},
@@ -447,6 +560,14 @@ var (
 			Resource:      jwtAuthBackendRoleResource(),
 			PathInventory: []string{"/auth/jwt/role/{name}"},
 		},
+		"vault_kmip_secret_role": {
+			Resource:      kmipSecretRoleResource(),
+			PathInventory: []string{"/kmip/scope/{scope}/role/{role}"},
+		},
+		"vault_kmip_secret_scope": {
+			Resource:      kmipSecretScopeResource(),
+			PathInventory: []string{"/kmip/scope", "/kmip/scope/{scope}"},
+		},
 		"vault_kubernetes_auth_backend_config": {
 			Resource:      kubernetesAuthBackendConfigResource(),
 			PathInventory: []string{"/auth/kubernetes/config"},
@@ -455,6 +576,14 @@ var (
 			Resource:      kubernetesAuthBackendRoleResource(),
 			PathInventory: []string{"/auth/kubernetes/role/{name}"},
 		},
+		"vault_kubernetes_secret_backend_role": {
+			Resource:      kubernetesSecretBackendRoleResource(),
+			PathInventory: []string{"/kubernetes/roles/{name}"},
+		},
+		"vault_kv_secret_v2_metadata": {
+			Resource:      kvSecretV2MetadataResource(),
+			PathInventory: []string{"/secret/metadata/{path}"},
+		},
 		"vault_okta_auth_backend": {
 			Resource:      oktaAuthBackendResource(),
 			PathInventory: []string{"/auth/okta/config"},
@@ -479,6 +608,14 @@ var (
 			Resource:      ldapAuthBackendGroupResource(),
 			PathInventory: []string{"/auth/ldap/groups/{name}"},
 		},
+		"vault_ldap_secret_backend_dynamic_role": {
+			Resource:      ldapSecretBackendDynamicRoleResource(),
+			PathInventory: []string{"/ldap/role/{name}"},
+		},
+		"vault_ldap_secret_backend_static_role": {
+			Resource:      ldapSecretBackendStaticRoleResource(),
+			PathInventory: []string{"/ldap/static-role/{name}", "/ldap/rotate-role/{name}"},
+		},
 		"vault_nomad_secret_backend": {
 			Resource: nomadSecretAccessBackendResource(),
 			PathInventory: []string{
@@ -505,11 +642,28 @@ var (
 			PathInventory:  []string{"/sys/policies/rgp/{name}"},
 			EnterpriseOnly: true,
 		},
+		"vault_rotate": {
+			Resource:      rotateResource(),
+			PathInventory: []string{"/sys/rotate"},
+		},
+		"vault_managed_keys": {
+			Resource:       managedKeysResource(),
+			PathInventory:  []string{"/sys/managed-keys/{type}/{name}"},
+			EnterpriseOnly: true,
+		},
 		"vault_mfa_duo": {
 			Resource:       mfaDuoResource(),
 			PathInventory:  []string{"/sys/mfa/method/duo/{name}"},
 			EnterpriseOnly: true,
 		},
+		"vault_lease": {
+			Resource: leaseResource(),
+			PathInventory: []string{
+				"/sys/leases/lookup",
+				"/sys/leases/renew",
+				"/sys/leases/revoke",
+			},
+		},
 		"vault_mount": {
 			Resource:      MountResource(),
 			PathInventory: []string{"/sys/mounts/{path}"},
@@ -539,6 +693,10 @@ var (
 			Resource:      identityEntityAliasResource(),
 			PathInventory: []string{"/identity/entity-alias"},
 		},
+		"vault_identity_entity_merge": {
+			Resource:      identityEntityMergeResource(),
+			PathInventory: []string{"/identity/entity/merge"},
+		},
 		"vault_identity_entity_policies": {
 			Resource:      identityEntityPoliciesResource(),
 			PathInventory: []string{"/identity/lookup/entity"},
@@ -575,6 +733,26 @@ var (
 			Resource:      identityOidcRole(),
 			PathInventory: []string{"/identity/oidc/role/{name}"},
 		},
+		"vault_identity_mfa_duo": {
+			Resource:      identityMFADuoResource(),
+			PathInventory: []string{"/identity/mfa/method/duo", "/identity/mfa/method/duo/{method_id}"},
+		},
+		"vault_identity_mfa_okta": {
+			Resource:      identityMFAOktaResource(),
+			PathInventory: []string{"/identity/mfa/method/okta", "/identity/mfa/method/okta/{method_id}"},
+		},
+		"vault_identity_mfa_pingid": {
+			Resource:      identityMFAPingIDResource(),
+			PathInventory: []string{"/identity/mfa/method/pingid", "/identity/mfa/method/pingid/{method_id}"},
+		},
+		"vault_identity_mfa_totp": {
+			Resource:      identityMFATOTPResource(),
+			PathInventory: []string{"/identity/mfa/method/totp", "/identity/mfa/method/totp/{method_id}"},
+		},
+		"vault_mfa_login_enforcement": {
+			Resource:      mfaLoginEnforcementResource(),
+			PathInventory: []string{"/identity/mfa/login-enforcement/{name}"},
+		},
 		"vault_rabbitmq_secret_backend": {
 			Resource: rabbitmqSecretBackendResource(),
 			PathInventory: []string{
@@ -586,6 +764,26 @@ var (
 			Resource:      rabbitmqSecretBackendRoleResource(),
 			PathInventory: []string{"/rabbitmq/roles/{name}"},
 		},
+		"vault_radius_auth_backend_config": {
+			Resource:      radiusAuthBackendConfigResource(),
+			PathInventory: []string{"/auth/radius/config"},
+		},
+		"vault_radius_auth_backend_user": {
+			Resource:      radiusAuthBackendUserResource(),
+			PathInventory: []string{"/auth/radius/users/{name}"},
+		},
+		"vault_raft_autopilot": {
+			Resource:      raftAutopilotResource(),
+			PathInventory: []string{"/sys/storage/raft/autopilot/configuration"},
+		},
+		"vault_raft_snapshot": {
+			Resource:      raftSnapshotResource(),
+			PathInventory: []string{"/sys/storage/raft/snapshot"},
+		},
+		"vault_raft_snapshot_agent_config": {
+			Resource:      raftSnapshotAgentConfigResource(),
+			PathInventory: []string{"/sys/storage/raft/snapshot-auto/config/{name}"},
+		},
 		"vault_password_policy": {
 			Resource:      passwordPolicyResource(),
 			PathInventory: []string{"/sys/policy/password/{name}"},
@@ -602,10 +800,29 @@ var (
 			Resource:      pkiSecretBackendCrlConfigResource(),
 			PathInventory: []string{"/pki/config/crl"},
 		},
+		"vault_pki_secret_backend_crl_rebuild": {
+			Resource: pkiSecretBackendCrlRebuildResource(),
+			PathInventory: []string{
+				"/pki/crl/rotate",
+				"/pki/crl/rotate-delta",
+			},
+		},
+		"vault_pki_secret_backend_config_acme": {
+			Resource:      pkiSecretBackendConfigACMEResource(),
+			PathInventory: []string{"/pki/config/acme"},
+		},
 		"vault_pki_secret_backend_config_ca": {
 			Resource:      pkiSecretBackendConfigCAResource(),
 			PathInventory: []string{"/pki/config/ca"},
 		},
+		"vault_pki_secret_backend_config_cluster": {
+			Resource:      pkiSecretBackendConfigClusterResource(),
+			PathInventory: []string{"/pki/config/cluster"},
+		},
+		"vault_pki_secret_backend_config_keys": {
+			Resource:      pkiSecretBackendConfigKeysResource(),
+			PathInventory: []string{"/pki/config/keys"},
+		},
 		"vault_pki_secret_backend_config_urls": {
 			Resource:      pkiSecretBackendConfigUrlsResource(),
 			PathInventory: []string{"/pki/config/urls"},
@@ -618,6 +835,10 @@ var (
 			Resource:      pkiSecretBackendIntermediateSetSignedResource(),
 			PathInventory: []string{"/pki/intermediate/set-signed"},
 		},
+		"vault_pki_secret_backend_issuer": {
+			Resource:      pkiSecretBackendIssuerResource(),
+			PathInventory: []string{"/pki/issuer/{issuer_ref}"},
+		},
 		"vault_pki_secret_backend_role": {
 			Resource:      pkiSecretBackendRoleResource(),
 			PathInventory: []string{"/pki/roles/{name}"},
@@ -634,6 +855,10 @@ var (
 			Resource:      pkiSecretBackendSignResource(),
 			PathInventory: []string{"/pki/sign/{role}"},
 		},
+		"vault_quota_config": {
+			Resource:      quotaConfigResource(),
+			PathInventory: []string{"/sys/quotas/config"},
+		},
 		"vault_quota_lease_count": {
 			Resource:      quotaLeaseCountResource(),
 			PathInventory: []string{"/sys/quotas/lease-count/{name}"},
@@ -654,10 +879,18 @@ var (
 			Resource:      terraformCloudSecretRoleResource(),
 			PathInventory: []string{"/terraform/role/{name}"},
 		},
+		"vault_totp_secret_backend_key": {
+			Resource:      totpSecretBackendKeyResource(),
+			PathInventory: []string{"/totp/keys/{name}"},
+		},
 		"vault_transit_secret_backend_key": {
 			Resource:      transitSecretBackendKeyResource(),
 			PathInventory: []string{"/transit/keys/{name}"},
 		},
+		"vault_transit_secret_backend_key_rotation": {
+			Resource:      transitSecretBackendKeyRotationResource(),
+			PathInventory: []string{"/transit/keys/{name}/rotate"},
+		},
 		"vault_transit_secret_cache_config": {
 			Resource:      transitSecretBackendCacheConfig(),
 			PathInventory: []string{"/transit/cache-config"},
@@ -697,6 +930,64 @@ func providerToken(d *schema.ResourceData) (string, error) {
 	return strings.TrimSpace(token), nil
 }
 
+// cloneClient returns a copy of client that can be modified without affecting
+// the provider's client.
+func cloneClient(client *api.Client) (*api.Client, error) {
+	c, err := client.Clone()
+	if err != nil {
+		return nil, fmt.Errorf("error cloning client: %s", err)
+	}
+	// Clone only copies the client's config, the headers include the
+	// namespace.
+	c.SetToken(client.Token())
+	c.SetHeaders(client.Headers())
+
+	return c, nil
+}
+
+// clientWithTimeout returns a copy of client whose requests time out after
+// timeout, for operations that take longer than a regular request, e.g. with
+// the timeout of the Terraform operation.
+func clientWithTimeout(client *api.Client, timeout time.Duration) (*api.Client, error) {
+	c, err := cloneClient(client)
+	if err != nil {
+		return nil, err
+	}
+	c.SetClientTimeout(timeout)
+
+	return c, nil
+}
+
+// namespaceSchema is the schema of the namespace field of resources that can
+// be managed in a namespace other than the provider's, see namespaceClient.
+func namespaceSchema() *schema.Schema {
+	return &schema.Schema{
+		Type:        schema.TypeString,
+		Optional:    true,
+		ForceNew:    true,
+		Description: "Namespace to manage the resource in, instead of the provider's. Requires Vault Enterprise.",
+	}
+}
+
+// namespaceClient returns the provider's client, or a copy of it using the
+// resource's namespace when set.
+func namespaceClient(d *schema.ResourceData, meta interface{}) (*api.Client, error) {
+	client := meta.(*api.Client)
+
+	namespace := d.Get("namespace").(string)
+	if namespace == "" {
+		return client, nil
+	}
+
+	c, err := cloneClient(client)
+	if err != nil {
+		return nil, err
+	}
+	c.SetNamespace(namespace)
+
+	return c, nil
+}
+
 func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 	clientConfig := api.DefaultConfig()
 	addr := d.Get("address").(string)
@@ -729,6 +1020,16 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		return nil, fmt.Errorf("failed to configure TLS for Vault API: %s", err)
 	}
 
+	// The default transport only keeps a few idle connections, which are
+	// quickly exhausted when creating many resources in parallel.
+	if v := d.Get("max_idle_connections").(int); v > 0 {
+		transport := clientConfig.HttpClient.Transport.(*http.Transport)
+		transport.MaxIdleConnsPerHost = v
+		if transport.MaxIdleConns < v {
+			transport.MaxIdleConns = v
+		}
+	}
+
 	clientConfig.HttpClient.Transport = logging.NewTransport("Vault", clientConfig.HttpClient.Transport)
 
 	client, err := api.NewClient(clientConfig)
@@ -753,6 +1054,10 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 
 	client.SetMaxRetries(d.Get("max_retries").(int))
 
+	if v, ok := d.GetOk("client_timeout"); ok {
+		client.SetClientTimeout(time.Duration(v.(int)) * time.Second)
+	}
+
 	// Try an get the token from the config or token helper
 	token, err := providerToken(d)
 	if err != nil {
@@ -795,6 +1100,19 @@ func providerConfigure(d *schema.ResourceData) (interface{}, error) {
 		return nil, errors.New("no vault token found")
 	}
 
+	// Batch tokens can't have children, and some environments only hand
+	// out very short-lived tokens, so optionally use the token as is.
+	if d.Get("skip_child_token").(bool) {
+		log.Printf("[INFO] Using the provided Vault token without creating a child token")
+
+		// Set the namespace to the requested namespace, if provided
+		namespace := d.Get("namespace").(string)
+		if namespace != "" {
+			client.SetNamespace(namespace)
+		}
+		return client, nil
+	}
+
 	tokenName := d.Get("token_name").(string)
 	if tokenName == "" {
 		tokenName = "terraform"
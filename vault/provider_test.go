@@ -3,15 +3,19 @@ package vault
 import (
 	"fmt"
 	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
 	"os"
 	"path"
 	"testing"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/pathorcontents"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
 	"github.com/hashicorp/vault/command/config"
 	"github.com/mitchellh/go-homedir"
 )
@@ -67,6 +71,18 @@ func testAccPreCheck(t *testing.T) {
 	}
 }
 
+// testAccClient returns a Vault client configured like the provider, for use
+// before the provider under test has been configured, e.g. in a PreCheck.
+func testAccClient(t *testing.T) *api.Client {
+	p := Provider()
+	d := (&schema.Resource{Schema: p.Schema}).TestResourceData()
+	meta, err := providerConfigure(d)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return meta.(*api.Client)
+}
+
 func testJWTLocal(t *testing.T) {
 	testAccPreCheck(t)
 	if v := os.Getenv("TEST_JWT"); v == "" {
@@ -213,6 +229,50 @@ func TestTokenReadProviderConfigureWithHeaders(t *testing.T) {
 	}
 }
 
+func TestClientWithTimeout(t *testing.T) {
+	// The client may retry after timing out, only the first request's
+	// headers are kept.
+	headers := make(chan http.Header, 1)
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		select {
+		case headers <- r.Header.Clone():
+		default:
+		}
+		time.Sleep(500 * time.Millisecond)
+	}))
+	defer server.Close()
+
+	config := api.DefaultConfig()
+	config.Address = server.URL
+	client, err := api.NewClient(config)
+	if err != nil {
+		t.Fatal(err)
+	}
+	client.SetToken("test-token")
+	client.SetNamespace("ns1")
+
+	timeoutClient, err := clientWithTimeout(client, 50*time.Millisecond)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if _, err := timeoutClient.Logical().Read("secret/test"); err == nil {
+		t.Fatal("expected the request to time out")
+	}
+
+	var h http.Header
+	select {
+	case h = <-headers:
+	case <-time.After(5 * time.Second):
+		t.Fatal("expected the request to reach the server")
+	}
+	if token := h.Get("X-Vault-Token"); token != "test-token" {
+		t.Errorf("expected token %q, got %q", "test-token", token)
+	}
+	if namespace := h.Get("X-Vault-Namespace"); namespace != "ns1" {
+		t.Errorf("expected namespace %q, got %q", "ns1", namespace)
+	}
+}
+
 func TestAccNamespaceProviderConfigure(t *testing.T) {
 	isEnterprise := os.Getenv("TF_ACC_ENTERPRISE")
 	if isEnterprise == "" {
@@ -623,6 +683,32 @@ func TestAccTokenName(t *testing.T) {
 	}
 }
 
+func TestAccSkipChildToken(t *testing.T) {
+	token := os.Getenv("VAULT_TOKEN")
+	if token == "" {
+		t.Skip("VAULT_TOKEN must be set to compare it with the token used by the provider")
+	}
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: `
+provider "vault" {
+    skip_child_token = true
+}
+
+data "vault_generic_secret" "test" {
+    path = "/auth/token/lookup-self"
+}
+`,
+				Check: resource.TestCheckResourceAttr("data.vault_generic_secret.test", "data.id", token),
+			},
+		},
+	})
+}
+
 func testHeaderConfig(headerName, headerValue string) string {
 	providerConfig := fmt.Sprintf(`
 	provider "vault" {
@@ -213,6 +213,8 @@ func approleAuthBackendRoleSecretIDRead(d *schema.ResourceData, meta interface{}
 	if err != nil {
 		// We need to check if the secret_id has expired
 		if util.IsExpiredTokenErr(err) {
+			log.Printf("[WARN] AppRole auth backend role SecretID %q expired, removing from state", id)
+			d.SetId("")
 			return nil
 		}
 		return fmt.Errorf("error reading AppRole auth backend role SecretID %q: %s", id, err)
@@ -280,7 +282,12 @@ func approleAuthBackendRoleSecretIDDelete(d *schema.ResourceData, meta interface
 		accessorParam: accessor,
 	})
 	if err != nil {
-		return fmt.Errorf("error deleting AppRole auth backend role SecretID %q", id)
+		// The SecretID is already gone if it expired or was used up
+		if util.IsExpiredTokenErr(err) {
+			log.Printf("[DEBUG] AppRole auth backend role SecretID %q already expired", id)
+			return nil
+		}
+		return fmt.Errorf("error deleting AppRole auth backend role SecretID %q: %s", id, err)
 	}
 	log.Printf("[DEBUG] Deleted AppRole auth backend role SecretID %q", id)
 
@@ -29,6 +29,15 @@ func TestAccAppRoleAuthBackendRoleSecretID_basic(t *testing.T) {
 					resource.TestCheckResourceAttrSet(secretIDResource, "accessor"),
 				),
 			},
+			{
+				// Destroying the SecretID outside of Terraform should plan to
+				// recreate it rather than fail.
+				Config: testAccAppRoleAuthBackendRoleSecretIDConfig_basic(backend, role),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAppRoleAuthBackendRoleSecretIDDestroyAccessor(secretIDResource),
+				),
+				ExpectNonEmptyPlan: true,
+			},
 		},
 	})
 }
@@ -98,6 +107,22 @@ func testAccCheckAppRoleAuthBackendRoleSecretIDDestroy(s *terraform.State) error
 	return nil
 }
 
+func testAccAppRoleAuthBackendRoleSecretIDDestroyAccessor(resourceName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %q not found in state", resourceName)
+		}
+		client := testProvider.Meta().(*api.Client)
+
+		path := approleAuthBackendRolePath(rs.Primary.Attributes["backend"], rs.Primary.Attributes["role_name"]) + "/secret-id-accessor/destroy"
+		_, err := client.Logical().Write(path, map[string]interface{}{
+			"secret_id_accessor": rs.Primary.Attributes["accessor"],
+		})
+		return err
+	}
+}
+
 func testAccAppRoleAuthBackendRoleSecretIDConfig_basic(backend, role string) string {
 	return fmt.Sprintf(`
 resource "vault_auth_backend" "approle" {
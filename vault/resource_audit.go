@@ -82,6 +82,9 @@ func auditWrite(d *schema.ResourceData, meta interface{}) error {
 	}
 
 	if err := client.Sys().EnableAuditWithOptions(path, opts); err != nil {
+		if !local && isPerformanceReplicationSecondary(client) {
+			return fmt.Errorf("error enabling audit backend: %s; this Vault cluster is a performance replication secondary, set local = true or enable the audit device on the primary instead", err)
+		}
 		return fmt.Errorf("error enabling audit backend: %s", err)
 	}
 
@@ -111,8 +114,6 @@ func auditRead(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Reading audit backends %s from Vault", path)
 
-	client.Sys().ListAudit()
-
 	audits, err := client.Sys().ListAudit()
 	if err != nil {
 		return fmt.Errorf("error reading from Vault: %s", err)
@@ -128,11 +129,27 @@ func auditRead(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
-	// Local is not returned by the List operation
 	d.Set("path", path)
 	d.Set("type", audit.Type)
 	d.Set("description", audit.Description)
+	d.Set("local", audit.Local)
 	d.Set("options", audit.Options)
 
 	return nil
 }
+
+// isPerformanceReplicationSecondary reports whether the Vault cluster is a
+// performance replication secondary. Any error reading the replication
+// status, e.g. on Vault OSS, is treated as not being a secondary.
+func isPerformanceReplicationSecondary(client *api.Client) bool {
+	resp, err := client.Logical().Read("sys/replication/status")
+	if err != nil || resp == nil {
+		return false
+	}
+
+	performance, ok := resp.Data["performance"].(map[string]interface{})
+	if !ok {
+		return false
+	}
+	return performance["mode"] == "secondary"
+}
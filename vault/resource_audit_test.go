@@ -18,7 +18,15 @@ func TestResourceAudit(t *testing.T) {
 		Steps: []resource.TestStep{
 			{
 				Config: testResourceAudit_initialConfig(path),
-				Check:  testResourceAudit_initialCheck(path),
+				Check: resource.ComposeTestCheckFunc(
+					testResourceAudit_initialCheck(path),
+					resource.TestCheckResourceAttr("vault_audit.test", "local", "true"),
+				),
+			},
+			{
+				ResourceName:      "vault_audit.test",
+				ImportState:       true,
+				ImportStateVerify: true,
 			},
 		},
 	})
@@ -23,6 +23,7 @@ func AuthBackendResource() *schema.Resource {
 		MigrateState: resourceAuthBackendMigrateState,
 
 		Schema: map[string]*schema.Schema{
+			"namespace": namespaceSchema(),
 			"type": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -95,12 +96,22 @@ func AuthBackendResource() *schema.Resource {
 			},
 
 			"tune": authMountTuneSchema(),
+
+			"force_delete": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Force-revoke all leases under the auth backend before disabling it on destroy. Lease revocation errors from the backend are ignored.",
+			},
 		},
 	}
 }
 
 func authBackendWrite(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*api.Client)
+	client, err := namespaceClient(d, meta)
+	if err != nil {
+		return err
+	}
 
 	mountType := d.Get("type").(string)
 	path := d.Get("path").(string)
@@ -132,13 +143,17 @@ func authBackendWrite(d *schema.ResourceData, meta interface{}) error {
 }
 
 func authBackendDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*api.Client)
+	client, err := namespaceClient(d, meta)
+	if err != nil {
+		return err
+	}
 
 	path := d.Id()
 
 	log.Printf("[DEBUG] Deleting auth %s from Vault", path)
 
-	if err := client.Sys().DisableAuth(path); err != nil {
+	leasePrefix := "auth/" + strings.Trim(path, "/")
+	if err := disableMountWithRetry(client, path, leasePrefix, d.Get("force_delete").(bool), client.Sys().DisableAuth); err != nil {
 		return fmt.Errorf("error disabling auth from Vault: %s", err)
 	}
 
@@ -146,7 +161,10 @@ func authBackendDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func authBackendRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*api.Client)
+	client, err := namespaceClient(d, meta)
+	if err != nil {
+		return err
+	}
 
 	targetPath := d.Id()
 
@@ -177,7 +195,10 @@ func authBackendRead(d *schema.ResourceData, meta interface{}) error {
 }
 
 func authBackendUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*api.Client)
+	client, err := namespaceClient(d, meta)
+	if err != nil {
+		return err
+	}
 
 	path := d.Id()
 	log.Printf("[DEBUG] Updating auth %s in Vault", path)
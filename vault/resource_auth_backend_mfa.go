@@ -0,0 +1,176 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func authBackendMFAResource() *schema.Resource {
+	return &schema.Resource{
+		Create: authBackendMFAWrite,
+		Update: authBackendMFAWrite,
+		Read:   authBackendMFARead,
+		Delete: authBackendMFADelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Path of the auth backend to configure MFA on.",
+				ValidateFunc: validateNoTrailingSlash,
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "duo",
+				Description:  "The MFA type. Only \"duo\" is supported by Vault.",
+				ValidateFunc: validation.StringInSlice([]string{"duo"}, false),
+			},
+			"secret_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Secret key for Duo.",
+			},
+			"integration_key": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Sensitive:   true,
+				Description: "Integration key for Duo.",
+			},
+			"api_hostname": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "API hostname for Duo.",
+			},
+			"username_format": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "Format string for mapping Vault usernames to Duo usernames. \"%s\" is replaced by the Vault username.",
+			},
+			"push_info": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Additional information displayed in the Duo push notification, as a URL-encoded key/value list.",
+			},
+			"user_agent": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "User agent to use when connecting to Duo.",
+			},
+		},
+	}
+}
+
+func authBackendMFAWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := strings.Trim(d.Get("backend").(string), "/")
+	mfaType := d.Get("type").(string)
+
+	// The credentials and settings have to be in place before MFA is
+	// enabled, or logins start failing in between.
+	accessPath := authBackendMFADuoPath(backend, "access")
+	log.Printf("[DEBUG] Writing Duo access to %q", accessPath)
+	if _, err := client.Logical().Write(accessPath, map[string]interface{}{
+		"skey": d.Get("secret_key").(string),
+		"ikey": d.Get("integration_key").(string),
+		"host": d.Get("api_hostname").(string),
+	}); err != nil {
+		return fmt.Errorf("error writing Duo access to %q: %s", accessPath, err)
+	}
+
+	configPath := authBackendMFADuoPath(backend, "config")
+	data := map[string]interface{}{
+		"push_info": d.Get("push_info").(string),
+	}
+	if v, ok := d.GetOk("username_format"); ok {
+		data["username_format"] = v.(string)
+	}
+	if v, ok := d.GetOk("user_agent"); ok {
+		data["user_agent"] = v.(string)
+	}
+	log.Printf("[DEBUG] Writing Duo config to %q", configPath)
+	if _, err := client.Logical().Write(configPath, data); err != nil {
+		return fmt.Errorf("error writing Duo config to %q: %s", configPath, err)
+	}
+
+	path := authBackendMFAConfigPath(backend)
+	log.Printf("[DEBUG] Enabling %s MFA on %q", mfaType, path)
+	if _, err := client.Logical().Write(path, map[string]interface{}{
+		"type": mfaType,
+	}); err != nil {
+		return fmt.Errorf("error enabling %s MFA on %q: %s", mfaType, path, err)
+	}
+	log.Printf("[DEBUG] Enabled %s MFA on %q", mfaType, path)
+
+	d.SetId(backend)
+
+	return authBackendMFARead(d, meta)
+}
+
+func authBackendMFARead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Id()
+	path := authBackendMFAConfigPath(backend)
+
+	log.Printf("[DEBUG] Reading MFA config %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading MFA config %q: %s", path, err)
+	}
+	if resp == nil || resp.Data["type"] == nil || resp.Data["type"] == "" {
+		log.Printf("[WARN] MFA config %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("type", resp.Data["type"])
+
+	configPath := authBackendMFADuoPath(backend, "config")
+	log.Printf("[DEBUG] Reading Duo config %q", configPath)
+	config, err := client.Logical().Read(configPath)
+	if err != nil {
+		return fmt.Errorf("error reading Duo config %q: %s", configPath, err)
+	}
+	if config != nil {
+		for _, k := range []string{"username_format", "push_info", "user_agent"} {
+			if v, ok := config.Data[k]; ok {
+				d.Set(k, v)
+			}
+		}
+	}
+
+	// The Duo access credentials can't be read back, so they're left as they
+	// are in state.
+
+	return nil
+}
+
+func authBackendMFADelete(d *schema.ResourceData, meta interface{}) error {
+	// Vault has no way to turn legacy MFA off again once a type is set, so
+	// the configuration is left in place.
+	log.Printf("[WARN] Legacy MFA on auth backend %q can't be disabled, removing it from state only", d.Id())
+	return nil
+}
+
+func authBackendMFAConfigPath(backend string) string {
+	return "auth/" + strings.Trim(backend, "/") + "/mfa_config"
+}
+
+func authBackendMFADuoPath(backend, op string) string {
+	return "auth/" + strings.Trim(backend, "/") + "/duo/" + op
+}
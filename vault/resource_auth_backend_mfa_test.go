@@ -0,0 +1,67 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAuthBackendMFA(t *testing.T) {
+	backend := acctest.RandomWithPrefix("userpass")
+	resourceName := "vault_auth_backend_mfa.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAuthBackendMFAConfig(backend, "8C7THtrIigh2rPZQMbguugt8IUftWhMRCOBzbuyz", "from=vault"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", backend),
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "type", "duo"),
+					resource.TestCheckResourceAttr(resourceName, "username_format", "%s@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "push_info", "from=vault"),
+				),
+			},
+			{
+				// Vault doesn't return the Duo access settings, which must not diff.
+				Config:   testAuthBackendMFAConfig(backend, "8C7THtrIigh2rPZQMbguugt8IUftWhMRCOBzbuyz", "from=vault"),
+				PlanOnly: true,
+			},
+			{
+				Config: testAuthBackendMFAConfig(backend, "9D8UIusJjhi3sQAQNchvvhu9JVguXiNSDPCacvza", "from=terraform"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "secret_key", "9D8UIusJjhi3sQAQNchvvhu9JVguXiNSDPCacvza"),
+					resource.TestCheckResourceAttr(resourceName, "push_info", "from=terraform"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret_key", "integration_key", "api_hostname"},
+			},
+		},
+	})
+}
+
+func testAuthBackendMFAConfig(backend, secretKey, pushInfo string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "test" {
+  type = "userpass"
+  path = "%s"
+}
+
+resource "vault_auth_backend_mfa" "test" {
+  backend         = vault_auth_backend.test.path
+  secret_key      = "%s"
+  integration_key = "BIACEUEAXI20BNWTEYXT"
+  api_hostname    = "api-2b5c39f5.duosecurity.com"
+  username_format = "%%s@example.com"
+  push_info       = "%s"
+}
+`, backend, secretKey, pushInfo)
+}
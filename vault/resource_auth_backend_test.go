@@ -29,6 +29,68 @@ func TestResourceAuth(t *testing.T) {
 	})
 }
 
+func TestResourceAuth_forceDelete(t *testing.T) {
+	path := acctest.RandomWithPrefix("userpass")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testAccCheckAuthBackendDestroy,
+			testAccCheckLeasesRevoked("auth/"+path+"/login/"),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceAuth_forceDeleteConfig(path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_auth_backend.test", "force_delete", "true"),
+					func(s *terraform.State) error {
+						// Log in so a lease exists under the mount when it's destroyed.
+						client := testProvider.Meta().(*api.Client)
+						_, err := client.Logical().Write("auth/"+path+"/login/alice", map[string]interface{}{
+							"password": "password",
+						})
+						return err
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckLeasesRevoked(prefix string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testProvider.Meta().(*api.Client)
+		resp, err := client.Logical().List("sys/leases/lookup/" + prefix)
+		if err != nil {
+			return err
+		}
+		if resp != nil && len(resp.Data["keys"].([]interface{})) > 0 {
+			return fmt.Errorf("leases still exist under %q: %v", prefix, resp.Data["keys"])
+		}
+		return nil
+	}
+}
+
+func testResourceAuth_forceDeleteConfig(path string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "test" {
+  type         = "userpass"
+  path         = "%s"
+  force_delete = true
+}
+
+resource "vault_generic_endpoint" "alice" {
+  path                 = "auth/${vault_auth_backend.test.path}/users/alice"
+  ignore_absent_fields = true
+  data_json            = <<EOT
+{
+  "password": "password"
+}
+EOT
+}
+`, path)
+}
+
 func testAccCheckAuthBackendDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
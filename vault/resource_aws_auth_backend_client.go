@@ -65,6 +65,12 @@ func awsAuthBackendClientResource() *schema.Resource {
 				Optional:    true,
 				Description: "Region to override the default region for making AWS STS API calls.",
 			},
+			"use_sts_region_from_client": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Use the STS region from the client's request headers instead of the configured sts_region.",
+			},
 			"iam_server_id_header_value": {
 				Type:        schema.TypeString,
 				Optional:    true,
@@ -84,6 +90,7 @@ func awsAuthBackendWrite(d *schema.ResourceData, meta interface{}) error {
 	iamEndpoint := d.Get("iam_endpoint").(string)
 	stsEndpoint := d.Get("sts_endpoint").(string)
 	stsRegion := d.Get("sts_region").(string)
+	useSTSRegionFromClient := d.Get("use_sts_region_from_client").(bool)
 
 	iamServerIDHeaderValue := d.Get("iam_server_id_header_value").(string)
 
@@ -94,6 +101,7 @@ func awsAuthBackendWrite(d *schema.ResourceData, meta interface{}) error {
 		"iam_endpoint":               iamEndpoint,
 		"sts_endpoint":               stsEndpoint,
 		"sts_region":                 stsRegion,
+		"use_sts_region_from_client": useSTSRegionFromClient,
 		"iam_server_id_header_value": iamServerIDHeaderValue,
 	}
 
@@ -108,6 +116,11 @@ func awsAuthBackendWrite(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("both sts_endpoint and sts_region need to be set")
 	}
 
+	// the client supplied region cannot be honoured with a fixed STS endpoint
+	if useSTSRegionFromClient && stsEndpoint != "" {
+		return fmt.Errorf("use_sts_region_from_client cannot be used with sts_endpoint")
+	}
+
 	log.Printf("[DEBUG] Writing AWS auth backend client config to %q", path)
 	_, err := client.Logical().Write(path, data)
 	if err != nil {
@@ -148,6 +161,7 @@ func awsAuthBackendRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("iam_endpoint", secret.Data["iam_endpoint"])
 	d.Set("sts_endpoint", secret.Data["sts_endpoint"])
 	d.Set("sts_region", secret.Data["sts_region"])
+	d.Set("use_sts_region_from_client", secret.Data["use_sts_region_from_client"])
 	d.Set("iam_server_id_header_value", secret.Data["iam_server_id_header_value"])
 	return nil
 }
@@ -112,6 +112,38 @@ func TestAccAWSAuthBackendClientStsRegionNoEndpoint(t *testing.T) {
 	})
 }
 
+func TestAccAWSAuthBackendClientStsRegionFromClient(t *testing.T) {
+	backend := acctest.RandomWithPrefix("aws")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckAWSAuthBackendClientDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSAuthBackendClientConfigSTSRegional(backend),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAWSAuthBackendClientCheck_attrs(backend),
+					resource.TestCheckResourceAttr("vault_aws_auth_backend_client.client", "sts_endpoint", "https://sts.eu-west-1.amazonaws.com"),
+					resource.TestCheckResourceAttr("vault_aws_auth_backend_client.client", "sts_region", "eu-west-1"),
+					resource.TestCheckResourceAttr("vault_aws_auth_backend_client.client", "use_sts_region_from_client", "false"),
+				),
+			},
+			{
+				Config: testAccAWSAuthBackendClientConfigSTSRegionFromClient(backend, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccAWSAuthBackendClientCheck_attrs(backend),
+					resource.TestCheckResourceAttr("vault_aws_auth_backend_client.client", "sts_endpoint", ""),
+					resource.TestCheckResourceAttr("vault_aws_auth_backend_client.client", "use_sts_region_from_client", "true"),
+				),
+			},
+			{
+				Config:      testAccAWSAuthBackendClientConfigSTSRegionFromClient(backend, true),
+				ExpectError: regexp.MustCompile("use_sts_region_from_client cannot be used with sts_endpoint"),
+			},
+		},
+	})
+}
+
 func testAccCheckAWSAuthBackendClientDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -165,6 +197,11 @@ func testAccAWSAuthBackendClientCheck_attrs(backend string) resource.TestCheckFu
 			"sts_region":                 "sts_region",
 			"iam_server_id_header_value": "iam_server_id_header_value",
 		}
+		if v, ok := resp.Data["use_sts_region_from_client"]; ok {
+			if fmt.Sprintf("%t", v) != instanceState.Attributes["use_sts_region_from_client"] {
+				return fmt.Errorf("expected use_sts_region_from_client of %q to be %q, got %t", endpoint, instanceState.Attributes["use_sts_region_from_client"], v)
+			}
+		}
 		for stateAttr, apiAttr := range attrs {
 			if resp.Data[apiAttr] == nil && instanceState.Attributes[stateAttr] == "" {
 				continue
@@ -273,3 +310,38 @@ resource "vault_aws_auth_backend_client" "client" {
   iam_server_id_header_value = "vault.test"
 }`, backend)
 }
+
+func testAccAWSAuthBackendClientConfigSTSRegional(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "aws" {
+  path = "%s"
+  type = "aws"
+  description = "Test auth backend for AWS backend client config"
+}
+
+resource "vault_aws_auth_backend_client" "client" {
+  backend = "${vault_auth_backend.aws.path}"
+  sts_endpoint = "https://sts.eu-west-1.amazonaws.com"
+  sts_region = "eu-west-1"
+}`, backend)
+}
+
+func testAccAWSAuthBackendClientConfigSTSRegionFromClient(backend string, withEndpoint bool) string {
+	var endpoint string
+	if withEndpoint {
+		endpoint = `
+  sts_endpoint = "https://sts.eu-west-1.amazonaws.com"
+  sts_region = "eu-west-1"`
+	}
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "aws" {
+  path = "%s"
+  type = "aws"
+  description = "Test auth backend for AWS backend client config"
+}
+
+resource "vault_aws_auth_backend_client" "client" {
+  backend = "${vault_auth_backend.aws.path}"
+  use_sts_region_from_client = true%s
+}`, backend, endpoint)
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/vault/api"
 )
 
@@ -24,11 +25,12 @@ func awsAuthBackendRoleResource() *schema.Resource {
 			ForceNew:    true,
 		},
 		"auth_type": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Default:     "iam",
-			Description: "The auth type permitted for this role.",
-			ForceNew:    true,
+			Type:         schema.TypeString,
+			Optional:     true,
+			Default:      "iam",
+			Description:  "The auth type permitted for this role.",
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice([]string{"iam", "ec2"}, false),
 		},
 		"bound_ami_id": {
 			Type:        schema.TypeString,
@@ -166,9 +168,10 @@ func awsAuthBackendRoleResource() *schema.Resource {
 			},
 		},
 		"inferred_entity_type": {
-			Type:        schema.TypeString,
-			Optional:    true,
-			Description: "The type of inferencing Vault should do.",
+			Type:         schema.TypeString,
+			Optional:     true,
+			Description:  "The type of inferencing Vault should do.",
+			ValidateFunc: validation.StringInSlice([]string{"ec2_instance"}, false),
 		},
 		"inferred_aws_region": {
 			Type:        schema.TypeString,
@@ -13,6 +13,28 @@ import (
 	"github.com/hashicorp/vault/api"
 )
 
+func TestAWSAuthBackendRoleValidation(t *testing.T) {
+	s := awsAuthBackendRoleResource().Schema
+
+	tests := []struct {
+		field, value string
+		valid        bool
+	}{
+		{"auth_type", "iam", true},
+		{"auth_type", "ec2", true},
+		{"auth_type", "lambda", false},
+		{"inferred_entity_type", "ec2_instance", true},
+		{"inferred_entity_type", "lambda_function", false},
+	}
+
+	for _, test := range tests {
+		_, errs := s[test.field].ValidateFunc(test.value, test.field)
+		if valid := len(errs) == 0; valid != test.valid {
+			t.Errorf("expected %s %q to be valid: %t, got errors: %v", test.field, test.value, test.valid, errs)
+		}
+	}
+}
+
 func TestAccAWSAuthBackendRole_importInferred(t *testing.T) {
 	backend := acctest.RandomWithPrefix("aws")
 	role := acctest.RandomWithPrefix("test-role")
@@ -6,6 +6,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/vault/api"
 )
 
@@ -85,6 +86,13 @@ func awsSecretBackendResource() *schema.Resource {
 				Optional:    true,
 				Description: "Specifies a custom HTTP STS endpoint to use.",
 			},
+			"username_template": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Template describing how dynamic usernames are generated.",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
 		},
 	}
 }
@@ -101,6 +109,7 @@ func awsSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
 	region := d.Get("region").(string)
 	iamEndpoint := d.Get("iam_endpoint").(string)
 	stsEndpoint := d.Get("sts_endpoint").(string)
+	usernameTemplate := d.Get("username_template").(string)
 
 	d.Partial(true)
 	log.Printf("[DEBUG] Mounting AWS backend at %q", path)
@@ -137,6 +146,9 @@ func awsSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
 	if stsEndpoint != "" {
 		data["sts_endpoint"] = stsEndpoint
 	}
+	if usernameTemplate != "" {
+		data["username_template"] = usernameTemplate
+	}
 	_, err = client.Logical().Write(path+"/config/root", data)
 	if err != nil {
 		return fmt.Errorf("error configuring root credentials for %q: %s", path, err)
@@ -154,6 +166,9 @@ func awsSecretBackendCreate(d *schema.ResourceData, meta interface{}) error {
 	if stsEndpoint != "" {
 		d.SetPartial("sts_endpoint")
 	}
+	if usernameTemplate != "" {
+		d.SetPartial("username_template")
+	}
 	d.Partial(false)
 
 	return awsSecretBackendRead(d, meta)
@@ -216,6 +231,9 @@ func awsSecretBackendRead(d *schema.ResourceData, meta interface{}) error {
 		if v, ok := resp.Data["sts_endpoint"].(string); ok {
 			d.Set("sts_endpoint", v)
 		}
+		if v, ok := resp.Data["username_template"].(string); ok {
+			d.Set("username_template", v)
+		}
 	}
 
 	d.Set("path", path)
@@ -245,7 +263,7 @@ func awsSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 		d.SetPartial("default_lease_ttl_seconds")
 		d.SetPartial("max_lease_ttl_seconds")
 	}
-	if d.HasChange("access_key") || d.HasChange("secret_key") || d.HasChange("region") || d.HasChange("iam_endpoint") || d.HasChange("sts_endpoint") {
+	if d.HasChange("access_key") || d.HasChange("secret_key") || d.HasChange("region") || d.HasChange("iam_endpoint") || d.HasChange("sts_endpoint") || d.HasChange("username_template") {
 		log.Printf("[DEBUG] Updating root credentials at %q", path+"/config/root")
 		data := map[string]interface{}{
 			"access_key": d.Get("access_key").(string),
@@ -254,6 +272,7 @@ func awsSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 		region := d.Get("region").(string)
 		iamEndpoint := d.Get("iam_endpoint").(string)
 		stsEndpoint := d.Get("sts_endpoint").(string)
+		usernameTemplate := d.Get("username_template").(string)
 		if region != "" {
 			data["region"] = region
 		}
@@ -263,6 +282,9 @@ func awsSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 		if stsEndpoint != "" {
 			data["sts_endpoint"] = stsEndpoint
 		}
+		if usernameTemplate != "" {
+			data["username_template"] = usernameTemplate
+		}
 		_, err := client.Logical().Write(path+"/config/root", data)
 		if err != nil {
 			return fmt.Errorf("error configuring root credentials for %q: %s", path, err)
@@ -280,6 +302,9 @@ func awsSecretBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 		if stsEndpoint != "" {
 			d.SetPartial("sts_endpoint")
 		}
+		if usernameTemplate != "" {
+			d.SetPartial("username_template")
+		}
 	}
 	d.Partial(false)
 	return awsSecretBackendRead(d, meta)
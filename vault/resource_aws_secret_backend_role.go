@@ -7,10 +7,13 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/terraform-provider-vault/util"
 	"github.com/hashicorp/vault/api"
 )
 
+var awsSecretBackendRoleCredentialTypes = []string{"iam_user", "assumed_role", "federation_token"}
+
 func awsSecretBackendRoleResource() *schema.Resource {
 	return &schema.Resource{
 		Create: awsSecretBackendRoleWrite,
@@ -21,6 +24,7 @@ func awsSecretBackendRoleResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
+		CustomizeDiff: awsSecretBackendRoleCustomizeDiff,
 
 		Schema: map[string]*schema.Schema{
 			"name": {
@@ -67,9 +71,10 @@ func awsSecretBackendRoleResource() *schema.Resource {
 				Removed:          `Use "policy_document".`,
 			},
 			"credential_type": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "Role credential type.",
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Role credential type. One of \"iam_user\", \"assumed_role\" or \"federation_token\".",
+				ValidateFunc: validation.StringInSlice(awsSecretBackendRoleCredentialTypes, false),
 			},
 			"role_arns": {
 				Type: schema.TypeSet,
@@ -77,9 +82,21 @@ func awsSecretBackendRoleResource() *schema.Resource {
 					Type: schema.TypeString,
 				},
 				Optional:      true,
-				ForceNew:      true,
 				ConflictsWith: []string{"policy", "policy_arn"},
-				Description:   "ARNs of AWS roles allowed to be assumed. Only valid when credential_type is 'assumed_role'",
+				Description:   "ARNs of AWS roles allowed to be assumed. Required when credential_type is 'assumed_role', and only valid then.",
+			},
+			"session_tags": {
+				Type: schema.TypeMap,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Optional:    true,
+				Description: "Session tags to set on the assumed role session. Only valid when credential_type is 'assumed_role'.",
+			},
+			"external_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "External ID to set when assuming the role. Only valid when credential_type is 'assumed_role'.",
 			},
 			"iam_groups": {
 				Type: schema.TypeSet,
@@ -153,6 +170,15 @@ func awsSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) error {
 		data["iam_groups"] = iamGroups
 	}
 
+	// Vault refuses the assumed_role settings for the other credential
+	// types, so they're only sent when set or being cleared.
+	if v, ok := d.GetOk("session_tags"); ok || d.HasChange("session_tags") {
+		data["session_tags"] = v
+	}
+	if v, ok := d.GetOk("external_id"); ok || d.HasChange("external_id") {
+		data["external_id"] = v
+	}
+
 	defaultStsTTL, defaultStsTTLOk := d.GetOk("default_sts_ttl")
 	maxStsTTL, maxStsTTLOk := d.GetOk("max_sts_ttl")
 	if credentialType == "assumed_role" || credentialType == "federation_token" {
@@ -230,11 +256,47 @@ func awsSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
 	if v, ok := secret.Data["iam_groups"]; ok {
 		d.Set("iam_groups", v)
 	}
+	if v, ok := secret.Data["session_tags"]; ok {
+		if err := d.Set("session_tags", v); err != nil {
+			return fmt.Errorf("error setting session_tags for role %q: %s", path, err)
+		}
+	}
+	if v, ok := secret.Data["external_id"]; ok {
+		d.Set("external_id", v)
+	}
 	d.Set("backend", strings.Join(pathPieces[:len(pathPieces)-2], "/"))
 	d.Set("name", pathPieces[len(pathPieces)-1])
 	return nil
 }
 
+// awsSecretBackendRoleCustomizeDiff checks the settings that only apply to
+// assumed_role credentials, which Vault would only refuse at apply time or
+// when the credentials are requested.
+func awsSecretBackendRoleCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("credential_type") || !d.NewValueKnown("role_arns") {
+		return nil
+	}
+
+	credentialType := d.Get("credential_type").(string)
+	roleARNs := d.Get("role_arns").(*schema.Set).Len()
+	if credentialType == "assumed_role" {
+		if roleARNs == 0 {
+			return fmt.Errorf("role_arns must be set when credential_type is assumed_role")
+		}
+		return nil
+	}
+
+	if roleARNs != 0 {
+		return fmt.Errorf("role_arns is only valid when credential_type is assumed_role")
+	}
+	for _, k := range []string{"session_tags", "external_id"} {
+		if _, ok := d.GetOk(k); ok {
+			return fmt.Errorf("%s is only valid when credential_type is assumed_role", k)
+		}
+	}
+	return nil
+}
+
 func awsSecretBackendRoleDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 
@@ -2,6 +2,8 @@ package vault
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
@@ -202,6 +204,67 @@ func TestAccAWSSecretBackendRole_nested(t *testing.T) {
 	})
 }
 
+func TestAccAWSSecretBackendRole_assumedRole(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-aws")
+	name := acctest.RandomWithPrefix("tf-test-aws")
+	resourceName := "vault_aws_secret_backend_role.test"
+	accessKey, secretKey := getTestAWSCreds(t)
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccAWSSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccAWSSecretBackendRoleConfig_assumedRole(name, backend, accessKey, secretKey, "assumed_role", ""),
+				ExpectError: regexp.MustCompile("role_arns must be set when credential_type is assumed_role"),
+			},
+			{
+				Config:      testAccAWSSecretBackendRoleConfig_assumedRole(name, backend, accessKey, secretKey, "federation_token", `role_arns = ["`+testAccAWSSecretBackendRoleRoleArn_basic+`"]`),
+				ExpectError: regexp.MustCompile("role_arns is only valid when credential_type is assumed_role"),
+			},
+			{
+				Config: testAccAWSSecretBackendRoleConfig_assumedRole(name, backend, accessKey, secretKey, "assumed_role", `
+  role_arns    = ["`+testAccAWSSecretBackendRoleRoleArn_basic+`", "`+testAccAWSSecretBackendRoleRoleArn_updated+`"]
+  external_id  = "external"
+  session_tags = {
+    team = "platform"
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "credential_type", "assumed_role"),
+					resource.TestCheckResourceAttr(resourceName, "role_arns.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "external_id", "external"),
+					resource.TestCheckResourceAttr(resourceName, "session_tags.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "session_tags.team", "platform"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// role_arns can be updated in place.
+				Config: testAccAWSSecretBackendRoleConfig_assumedRole(name, backend, accessKey, secretKey, "assumed_role", `role_arns = ["`+testAccAWSSecretBackendRoleRoleArn_updated+`"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "role_arns.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "role_arns.2518714066", testAccAWSSecretBackendRoleRoleArn_updated),
+					resource.TestCheckResourceAttr(resourceName, "external_id", ""),
+					resource.TestCheckResourceAttr(resourceName, "session_tags.%", "0"),
+				),
+			},
+			{
+				Config: testAccAWSSecretBackendRoleConfig_assumedRole(name, backend, accessKey, secretKey, "federation_token", `policy_document = "`+strings.ReplaceAll(testAccAWSSecretBackendRolePolicyInline_basic, `"`, `\"`)+`"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "credential_type", "federation_token"),
+					resource.TestCheckResourceAttr(resourceName, "role_arns.#", "0"),
+					util.TestCheckResourceAttrJSON(resourceName, "policy_document", testAccAWSSecretBackendRolePolicyInline_basic),
+				),
+			},
+		},
+	})
+}
+
 func testAccAWSSecretBackendRoleCheckDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -232,6 +295,7 @@ resource "vault_aws_secret_backend_role" "test_policy_inline" {
   name = "%s-policy-inline"
   policy_document = %q
   credential_type = "assumed_role"
+  role_arns = ["%s"]
   backend = "${vault_aws_secret_backend.test.path}"
 }
 
@@ -256,7 +320,7 @@ resource "vault_aws_secret_backend_role" "test_role_arns" {
 	credential_type = "assumed_role"
 	backend = "${vault_aws_secret_backend.test.path}"
 }
-`, path, accessKey, secretKey, name, testAccAWSSecretBackendRolePolicyInline_basic, name, testAccAWSSecretBackendRolePolicyArn_basic, name, testAccAWSSecretBackendRolePolicyInline_basic, testAccAWSSecretBackendRolePolicyArn_basic, name, testAccAWSSecretBackendRoleRoleArn_basic)
+`, path, accessKey, secretKey, name, testAccAWSSecretBackendRolePolicyInline_basic, testAccAWSSecretBackendRoleRoleArn_basic, name, testAccAWSSecretBackendRolePolicyArn_basic, name, testAccAWSSecretBackendRolePolicyInline_basic, testAccAWSSecretBackendRolePolicyArn_basic, name, testAccAWSSecretBackendRoleRoleArn_basic)
 }
 
 func testAccAWSSecretBackendRoleConfig_updated(name, path, accessKey, secretKey string) string {
@@ -271,6 +335,7 @@ resource "vault_aws_secret_backend_role" "test_policy_inline" {
   name = "%s-policy-inline"
   policy_document = %q
   credential_type = "assumed_role"
+  role_arns = ["%s"]
   backend = "${vault_aws_secret_backend.test.path}"
   default_sts_ttl = 3600
   max_sts_ttl = 21600
@@ -305,8 +370,26 @@ resource "vault_aws_secret_backend_role" "test_role_arns" {
 resource "vault_aws_secret_backend_role" "test_role_groups" {
 	name = "%s-role-groups"
 	credential_type = "assumed_role"
+	role_arns = ["%s"]
 	iam_groups = ["group1", "group2"]
 	backend = "${vault_aws_secret_backend.test.path}"
 }
-`, path, accessKey, secretKey, name, testAccAWSSecretBackendRolePolicyInline_updated, name, testAccAWSSecretBackendRolePolicyArn_updated, name, testAccAWSSecretBackendRolePolicyInline_updated, testAccAWSSecretBackendRolePolicyArn_updated, name, testAccAWSSecretBackendRoleRoleArn_updated, name)
+`, path, accessKey, secretKey, name, testAccAWSSecretBackendRolePolicyInline_updated, testAccAWSSecretBackendRoleRoleArn_basic, name, testAccAWSSecretBackendRolePolicyArn_updated, name, testAccAWSSecretBackendRolePolicyInline_updated, testAccAWSSecretBackendRolePolicyArn_updated, name, testAccAWSSecretBackendRoleRoleArn_updated, name, testAccAWSSecretBackendRoleRoleArn_basic)
+}
+
+func testAccAWSSecretBackendRoleConfig_assumedRole(name, path, accessKey, secretKey, credentialType, extra string) string {
+	return fmt.Sprintf(`
+resource "vault_aws_secret_backend" "test" {
+  path = "%s"
+  access_key = "%s"
+  secret_key = "%s"
+}
+
+resource "vault_aws_secret_backend_role" "test" {
+  name = "%s"
+  backend = vault_aws_secret_backend.test.path
+  credential_type = "%s"
+  %s
+}
+`, path, accessKey, secretKey, name, credentialType, extra)
 }
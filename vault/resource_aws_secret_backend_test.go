@@ -96,6 +96,29 @@ func TestAccAWSSecretBackend_import(t *testing.T) {
 	})
 }
 
+func TestAccAWSSecretBackend_usernameTemplate(t *testing.T) {
+	path := acctest.RandomWithPrefix("tf-test-aws")
+	accessKey, secretKey := getTestAWSCreds(t)
+	templ := `{{ printf "vault-%s-%s-%s" (printf "%s-%s" (.DisplayName) (.PolicyName) | truncate 42) (unix_time) (random 20) | truncate 64 }}`
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccAWSSecretBackendCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccAWSSecretBackendConfig_usernameTemplate(path, accessKey, secretKey, templ),
+				Check:  resource.TestCheckResourceAttr("vault_aws_secret_backend.test", "username_template", templ),
+			},
+			{
+				ResourceName:            "vault_aws_secret_backend.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"access_key", "secret_key", "region"},
+			},
+		},
+	})
+}
+
 func testAccAWSSecretBackendCheckDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -157,3 +180,13 @@ resource "vault_aws_secret_backend" "test" {
   region = "us-west-1"
 }`, path)
 }
+
+func testAccAWSSecretBackendConfig_usernameTemplate(path, accessKey, secretKey, templ string) string {
+	return fmt.Sprintf(`
+resource "vault_aws_secret_backend" "test" {
+  path              = "%s"
+  access_key        = "%s"
+  secret_key        = "%s"
+  username_template = %q
+}`, path, accessKey, secretKey, templ)
+}
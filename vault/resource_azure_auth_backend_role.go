@@ -313,28 +313,10 @@ func azureAuthBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("backend", backend)
 	d.Set("role", role)
 
-	if _, ok := d.GetOk("bound_service_principal_ids"); ok {
-		d.Set("bound_service_principal_ids", resp.Data["bound_service_principal_ids"])
-	}
-
-	if _, ok := d.GetOk("bound_group_ids"); ok {
-		d.Set("bound_group_ids", resp.Data["bound_group_ids"])
-	}
-
-	if _, ok := d.GetOk("bound_locations"); ok {
-		d.Set("bound_locations", resp.Data["bound_locations"])
-	}
-
-	if _, ok := d.GetOk("bound_subscription_ids"); ok {
-		d.Set("bound_subscription_ids", resp.Data["bound_subscription_ids"])
-	}
-
-	if _, ok := d.GetOk("bound_resource_groups"); ok {
-		d.Set("bound_resource_groups", resp.Data["bound_resource_groups"])
-	}
-
-	if _, ok := d.GetOk("bound_scale_sets"); ok {
-		d.Set("bound_scale_sets", resp.Data["bound_scale_sets"])
+	for _, k := range []string{"bound_service_principal_ids", "bound_group_ids", "bound_locations", "bound_subscription_ids", "bound_resource_groups", "bound_scale_sets"} {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting %s for Azure auth backend role %q: %s", k, path, err)
+		}
 	}
 
 	return nil
@@ -363,7 +345,7 @@ func azureAuthBackendRoleUpdate(d *schema.ResourceData, meta interface{}) error
 		data["policies"] = d.Get("policies").([]interface{})
 	}
 
-	if _, ok := d.GetOk("bound_service_principal_ids"); ok {
+	if d.HasChange("bound_service_principal_ids") {
 		iSPI := d.Get("bound_service_principal_ids").([]interface{})
 		bound_service_principal_ids := make([]string, len(iSPI))
 		for i, iSP := range iSPI {
@@ -371,7 +353,7 @@ func azureAuthBackendRoleUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 		data["bound_service_principal_ids"] = bound_service_principal_ids
 	}
-	if _, ok := d.GetOk("bound_group_ids"); ok {
+	if d.HasChange("bound_group_ids") {
 		iGI := d.Get("bound_group_ids").([]interface{})
 		bound_group_ids := make([]string, len(iGI))
 		for i, iG := range iGI {
@@ -379,7 +361,7 @@ func azureAuthBackendRoleUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 		data["bound_group_ids"] = bound_group_ids
 	}
-	if _, ok := d.GetOk("bound_locations"); ok {
+	if d.HasChange("bound_locations") {
 		iLS := d.Get("bound_locations").([]interface{})
 		bound_locations := make([]string, len(iLS))
 		for i, iL := range iLS {
@@ -387,7 +369,7 @@ func azureAuthBackendRoleUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 		data["bound_locations"] = bound_locations
 	}
-	if _, ok := d.GetOk("bound_subscription_ids"); ok {
+	if d.HasChange("bound_subscription_ids") {
 		iSI := d.Get("bound_subscription_ids").([]interface{})
 		bound_subscription_ids := make([]string, len(iSI))
 		for i, iS := range iSI {
@@ -395,7 +377,7 @@ func azureAuthBackendRoleUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 		data["bound_subscription_ids"] = bound_subscription_ids
 	}
-	if _, ok := d.GetOk("bound_resource_groups"); ok {
+	if d.HasChange("bound_resource_groups") {
 		iRGN := d.Get("bound_resource_groups").([]interface{})
 		bound_resource_groups := make([]string, len(iRGN))
 		for i, iRG := range iRGN {
@@ -403,7 +385,7 @@ func azureAuthBackendRoleUpdate(d *schema.ResourceData, meta interface{}) error
 		}
 		data["bound_resource_groups"] = bound_resource_groups
 	}
-	if _, ok := d.GetOk("bound_scale_sets"); ok {
+	if d.HasChange("bound_scale_sets") {
 		iSS := d.Get("bound_scale_sets").([]interface{})
 		bound_scale_sets := make([]string, len(iSS))
 		for i, iS := range iSS {
@@ -63,6 +63,18 @@ func TestAzureAuthBackendRole(t *testing.T) {
 						"token_policies.#", "0"),
 				),
 			},
+			{
+				Config: testAzureAuthBackendRoleBoundsUpdated(backend, name),
+				Check: resource.ComposeTestCheckFunc(
+					testAzureAuthBackendRoleCheck_attrs(backend, name),
+					resource.TestCheckResourceAttr("vault_azure_auth_backend_role.test",
+						"bound_locations.#", "2"),
+					resource.TestCheckResourceAttr("vault_azure_auth_backend_role.test",
+						"bound_locations.1", "east us"),
+					resource.TestCheckResourceAttr("vault_azure_auth_backend_role.test",
+						"bound_resource_groups.#", "0"),
+				),
+			},
 		},
 	})
 }
@@ -269,3 +281,20 @@ resource "vault_azure_auth_backend_role" "test" {
 }
 `, backend, name)
 }
+
+func testAzureAuthBackendRoleBoundsUpdated(backend, name string) string {
+
+	return fmt.Sprintf(`
+
+resource "vault_auth_backend" "azure" {
+    path = "%s"
+    type = "azure"
+}
+
+resource "vault_azure_auth_backend_role" "test" {
+    backend                    = "${vault_auth_backend.azure.path}"
+    role                       = "%s"
+    bound_locations            = ["west us", "east us"]
+}
+`, backend, name)
+}
@@ -0,0 +1,219 @@
+package vault
+
+import (
+	"encoding/base64"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+const configUICustomMessagesPath = "sys/config/ui/custom-messages"
+
+func configUICustomMessageResource() *schema.Resource {
+	return &schema.Resource{
+		Create: configUICustomMessageCreate,
+		Read:   configUICustomMessageRead,
+		Update: configUICustomMessageUpdate,
+		Delete: configUICustomMessageDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"title": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The title of the custom message.",
+			},
+			"message": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The text of the custom message.",
+			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "banner",
+				Description:  "How the message is displayed, either banner or modal.",
+				ValidateFunc: validation.StringInSlice([]string{"banner", "modal"}, false),
+			},
+			"authenticated": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether the message is displayed after logging in, or on the login page.",
+			},
+			"start_time": {
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The time the message starts being displayed, in RFC3339 format.",
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: configUICustomMessageTimeDiffSuppress,
+			},
+			"end_time": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "The time the message stops being displayed, in RFC3339 format.",
+				ValidateFunc:     validation.IsRFC3339Time,
+				DiffSuppressFunc: configUICustomMessageTimeDiffSuppress,
+			},
+			"link": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "A link displayed with the message.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"title": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The text of the link.",
+						},
+						"href": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The URL of the link.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+// configUICustomMessageTimeDiffSuppress ignores differences in how the same
+// time is written, Vault returns times in UTC.
+func configUICustomMessageTimeDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	oldTime, err := time.Parse(time.RFC3339, old)
+	if err != nil {
+		return false
+	}
+	newTime, err := time.Parse(time.RFC3339, new)
+	if err != nil {
+		return false
+	}
+	return oldTime.Equal(newTime)
+}
+
+func configUICustomMessageData(d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"title":         d.Get("title").(string),
+		"message":       base64.StdEncoding.EncodeToString([]byte(d.Get("message").(string))),
+		"type":          d.Get("type").(string),
+		"authenticated": d.Get("authenticated").(bool),
+		"start_time":    d.Get("start_time").(string),
+	}
+
+	if v, ok := d.GetOk("end_time"); ok {
+		data["end_time"] = v.(string)
+	}
+
+	// Vault takes the link as a single title to href mapping.
+	if v, ok := d.GetOk("link"); ok {
+		link := v.([]interface{})[0].(map[string]interface{})
+		data["link"] = map[string]interface{}{
+			link["title"].(string): link["href"].(string),
+		}
+	}
+
+	return data
+}
+
+func configUICustomMessageCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	title := d.Get("title").(string)
+
+	log.Printf("[DEBUG] Creating UI custom message %q", title)
+	resp, err := client.Logical().Write(configUICustomMessagesPath, configUICustomMessageData(d))
+	if err != nil {
+		return fmt.Errorf("error creating UI custom message %q: %s", title, err)
+	}
+	if resp == nil || resp.Data["id"] == nil {
+		return fmt.Errorf("no ID returned when creating UI custom message %q", title)
+	}
+	log.Printf("[DEBUG] Created UI custom message %q", title)
+
+	d.SetId(resp.Data["id"].(string))
+
+	return configUICustomMessageRead(d, meta)
+}
+
+func configUICustomMessageRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	id := d.Id()
+	path := configUICustomMessagesPath + "/" + id
+
+	log.Printf("[DEBUG] Reading UI custom message %q", id)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading UI custom message %q: %s", id, err)
+	}
+	log.Printf("[DEBUG] Read UI custom message %q", id)
+	if resp == nil {
+		log.Printf("[WARN] UI custom message %q not found, removing from state", id)
+		d.SetId("")
+		return nil
+	}
+
+	for _, k := range []string{"title", "type", "authenticated", "start_time", "end_time"} {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting %s for UI custom message %q: %s", k, id, err)
+		}
+	}
+
+	if v, ok := resp.Data["message"].(string); ok {
+		message, err := base64.StdEncoding.DecodeString(v)
+		if err != nil {
+			return fmt.Errorf("error decoding message of UI custom message %q: %s", id, err)
+		}
+		d.Set("message", string(message))
+	}
+
+	var link []map[string]interface{}
+	if v, ok := resp.Data["link"].(map[string]interface{}); ok {
+		for title, href := range v {
+			link = append(link, map[string]interface{}{
+				"title": title,
+				"href":  href,
+			})
+		}
+	}
+	if err := d.Set("link", link); err != nil {
+		return fmt.Errorf("error setting link for UI custom message %q: %s", id, err)
+	}
+
+	return nil
+}
+
+func configUICustomMessageUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	id := d.Id()
+	path := configUICustomMessagesPath + "/" + id
+
+	log.Printf("[DEBUG] Updating UI custom message %q", id)
+	if _, err := client.Logical().Write(path, configUICustomMessageData(d)); err != nil {
+		return fmt.Errorf("error updating UI custom message %q: %s", id, err)
+	}
+	log.Printf("[DEBUG] Updated UI custom message %q", id)
+
+	return configUICustomMessageRead(d, meta)
+}
+
+func configUICustomMessageDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	id := d.Id()
+	path := configUICustomMessagesPath + "/" + id
+
+	log.Printf("[DEBUG] Deleting UI custom message %q", id)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return fmt.Errorf("error deleting UI custom message %q: %s", id, err)
+	}
+	log.Printf("[DEBUG] Deleted UI custom message %q", id)
+
+	return nil
+}
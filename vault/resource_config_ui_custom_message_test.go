@@ -0,0 +1,108 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccConfigUICustomMessage(t *testing.T) {
+	if os.Getenv("TF_ACC_ENTERPRISE") == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+
+	resourceName := "vault_config_ui_custom_message.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccConfigUICustomMessageCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigUICustomMessageConfig("banner", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "title", "Maintenance"),
+					resource.TestCheckResourceAttr(resourceName, "message", "Vault will be upgraded."),
+					resource.TestCheckResourceAttr(resourceName, "type", "banner"),
+					resource.TestCheckResourceAttr(resourceName, "authenticated", "true"),
+					resource.TestCheckResourceAttr(resourceName, "link.#", "0"),
+				),
+			},
+			{
+				Config: testAccConfigUICustomMessageConfig("modal", `
+  end_time = "2100-01-02T00:00:00+00:00"
+
+  link {
+    title = "Details"
+    href  = "https://status.example.com"
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "type", "modal"),
+					resource.TestCheckResourceAttr(resourceName, "link.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "link.0.title", "Details"),
+					resource.TestCheckResourceAttr(resourceName, "link.0.href", "https://status.example.com"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestConfigUICustomMessageTimeDiffSuppress(t *testing.T) {
+	tests := []struct {
+		old      string
+		new      string
+		suppress bool
+	}{
+		{"2100-01-01T00:00:00Z", "2100-01-01T00:00:00Z", true},
+		{"2100-01-01T00:00:00Z", "2100-01-01T00:00:00+00:00", true},
+		{"2100-01-01T00:00:00Z", "2100-01-01T02:00:00+02:00", true},
+		{"2100-01-01T00:00:00Z", "2100-01-01T01:00:00Z", false},
+		{"", "2100-01-01T00:00:00Z", false},
+	}
+
+	for _, test := range tests {
+		if actual := configUICustomMessageTimeDiffSuppress("start_time", test.old, test.new, nil); actual != test.suppress {
+			t.Errorf("old %q, new %q: expected suppress %t, got %t", test.old, test.new, test.suppress, actual)
+		}
+	}
+}
+
+func testAccConfigUICustomMessageCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_config_ui_custom_message" {
+			continue
+		}
+		resp, err := client.Logical().Read(configUICustomMessagesPath + "/" + rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if resp != nil {
+			return fmt.Errorf("UI custom message %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccConfigUICustomMessageConfig(messageType, extra string) string {
+	return fmt.Sprintf(`
+resource "vault_config_ui_custom_message" "test" {
+  title      = "Maintenance"
+  message    = "Vault will be upgraded."
+  type       = "%s"
+  start_time = "2100-01-01T00:00:00Z"
+%s}
+`, messageType, extra)
+}
@@ -0,0 +1,101 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func configUIHeaderPath(name string) string {
+	return "sys/config/ui/headers/" + name
+}
+
+func configUIHeaderResource() *schema.Resource {
+	return &schema.Resource{
+		Create: configUIHeaderWrite,
+		Read:   configUIHeaderRead,
+		Update: configUIHeaderWrite,
+		Delete: configUIHeaderDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name of the header.",
+			},
+			"values": {
+				Type:        schema.TypeList,
+				Required:    true,
+				MinItems:    1,
+				Description: "The values of the header.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func configUIHeaderWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Get("name").(string)
+	path := configUIHeaderPath(name)
+
+	data := map[string]interface{}{
+		"values": d.Get("values").([]interface{}),
+	}
+
+	log.Printf("[DEBUG] Writing UI header %q", name)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing UI header %q: %s", name, err)
+	}
+	log.Printf("[DEBUG] Wrote UI header %q", name)
+
+	d.SetId(name)
+
+	return configUIHeaderRead(d, meta)
+}
+
+func configUIHeaderRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	name := d.Id()
+
+	log.Printf("[DEBUG] Reading UI header %q", name)
+	resp, err := client.Logical().Read(configUIHeaderPath(name))
+	if err != nil {
+		return fmt.Errorf("error reading UI header %q: %s", name, err)
+	}
+	log.Printf("[DEBUG] Read UI header %q", name)
+	if resp == nil {
+		log.Printf("[WARN] UI header %q not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+	if err := d.Set("values", resp.Data["values"]); err != nil {
+		return fmt.Errorf("error setting values for UI header %q: %s", name, err)
+	}
+
+	return nil
+}
+
+func configUIHeaderDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	name := d.Id()
+
+	log.Printf("[DEBUG] Deleting UI header %q", name)
+	if _, err := client.Logical().Delete(configUIHeaderPath(name)); err != nil {
+		return fmt.Errorf("error deleting UI header %q: %s", name, err)
+	}
+	log.Printf("[DEBUG] Deleted UI header %q", name)
+
+	return nil
+}
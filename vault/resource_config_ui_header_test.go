@@ -0,0 +1,73 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccConfigUIHeader(t *testing.T) {
+	name := acctest.RandomWithPrefix("X-Tf-Test")
+	resourceName := "vault_config_ui_header.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccConfigUIHeaderCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccConfigUIHeaderConfig(name, `["a"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", name),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "values.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "values.0", "a"),
+				),
+			},
+			{
+				Config: testAccConfigUIHeaderConfig(name, `["b", "c"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "values.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "values.0", "b"),
+					resource.TestCheckResourceAttr(resourceName, "values.1", "c"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccConfigUIHeaderCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_config_ui_header" {
+			continue
+		}
+		resp, err := client.Logical().Read(configUIHeaderPath(rs.Primary.ID))
+		if err != nil {
+			return err
+		}
+		if resp != nil {
+			return fmt.Errorf("UI header %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccConfigUIHeaderConfig(name, values string) string {
+	return fmt.Sprintf(`
+resource "vault_config_ui_header" "test" {
+  name   = "%s"
+  values = %s
+}
+`, name, values)
+}
@@ -1,6 +1,7 @@
 package vault
 
 import (
+	"encoding/base64"
 	"fmt"
 	"log"
 	"regexp"
@@ -13,6 +14,17 @@ import (
 var (
 	consulSecretBackendRoleBackendFromPathRegex = regexp.MustCompile("^(.+)/roles/.+$")
 	consulSecretBackendRoleNameFromPathRegex    = regexp.MustCompile("^.+/roles/(.+$)")
+
+	// consulSecretBackendRoleGrantFields are the fields that grant permissions
+	// to client tokens, at least one of which Consul requires.
+	consulSecretBackendRoleGrantFields = []string{
+		"policy",
+		"policies",
+		"consul_policies",
+		"consul_roles",
+		"service_identities",
+		"node_identities",
+	}
 )
 
 func consulSecretBackendRoleResource() *schema.Resource {
@@ -48,13 +60,56 @@ func consulSecretBackendRoleResource() *schema.Resource {
 				ConflictsWith: []string{"path"},
 			},
 			"policies": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Description:   "List of Consul policies to associate with this role",
+				Deprecated:    "use `consul_policies` instead",
+				ConflictsWith: []string{"consul_policies", "policy"},
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"consul_policies": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				Description:   "List of Consul policies to associate with this role",
+				ConflictsWith: []string{"policies", "policy"},
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"consul_roles": {
 				Type:        schema.TypeList,
-				Required:    true,
-				Description: "List of Consul policies to associate with this role",
+				Optional:    true,
+				Description: "List of Consul roles to associate with this role",
 				Elem: &schema.Schema{
 					Type: schema.TypeString,
 				},
 			},
+			"service_identities": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "List of Consul service identities to associate with this role, in the format <service>[:<datacenter1>,<datacenter2>].",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"node_identities": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "List of Consul node identities to associate with this role, in the format <node>:<datacenter>.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"policy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Inline Consul ACL policy to associate with this role. Only supported by Consul versions prior to 1.4.",
+				// Consul 1.4 and later ignore the inline policy, so using
+				// both would silently drop it on one version or the other.
+				ConflictsWith: []string{"policies", "consul_policies"},
+			},
 			"max_ttl": {
 				Type:        schema.TypeInt,
 				Optional:    true,
@@ -79,10 +134,42 @@ func consulSecretBackendRoleResource() *schema.Resource {
 				Description: "Indicates that the token should not be replicated globally and instead be local to the current datacenter.",
 				Default:     false,
 			},
+			"revoke_on_delete": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Revoke all leases issued for this role before deleting it.",
+				Default:     false,
+			},
 		},
+		CustomizeDiff: consulSecretBackendRoleCustomizeDiff,
 	}
 }
 
+// consulSecretBackendRoleCustomizeDiff rejects client token roles with nothing
+// to grant, which Consul would otherwise only reject when the role is written.
+func consulSecretBackendRoleCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Get("token_type").(string) == "management" {
+		return nil
+	}
+	for _, k := range consulSecretBackendRoleGrantFields {
+		if !d.NewValueKnown(k) {
+			return nil
+		}
+		switch v := d.Get(k).(type) {
+		case string:
+			if v != "" {
+				return nil
+			}
+		case []interface{}:
+			if len(v) > 0 {
+				return nil
+			}
+		}
+	}
+	return fmt.Errorf("at least one of %s must be set for a %q token role",
+		strings.Join(consulSecretBackendRoleGrantFields, ", "), d.Get("token_type"))
+}
+
 func consulSecretBackendRoleGetBackend(d *schema.ResourceData) string {
 	if v, ok := d.GetOk("backend"); ok {
 		return v.(string)
@@ -105,10 +192,19 @@ func consulSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) erro
 
 	path := consulSecretBackendRolePath(backend, name)
 
-	policies := d.Get("policies").([]interface{})
+	policies := d.Get("consul_policies").([]interface{})
+	if v, ok := d.GetOk("policies"); ok {
+		policies = v.([]interface{})
+	}
+	policy := d.Get("policy").(string)
 
 	payload := map[string]interface{}{
-		"policies": policies,
+		"policies":           policies,
+		"consul_roles":       d.Get("consul_roles").([]interface{}),
+		"service_identities": d.Get("service_identities").([]interface{}),
+		"node_identities":    d.Get("node_identities").([]interface{}),
+		"policy":             base64.StdEncoding.EncodeToString([]byte(policy)),
+		"local":              d.Get("local").(bool),
 	}
 
 	if v, ok := d.GetOkExists("max_ttl"); ok {
@@ -120,9 +216,6 @@ func consulSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) erro
 	if v, ok := d.GetOkExists("token_type"); ok {
 		payload["token_type"] = v
 	}
-	if v, ok := d.GetOkExists("local"); ok {
-		payload["local"] = v
-	}
 
 	log.Printf("[DEBUG] Configuring Consul secrets backend role at %q", path)
 
@@ -172,11 +265,30 @@ func consulSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error
 	} else {
 		d.Set("backend", backend)
 	}
-	d.Set("policies", data["policies"])
+	if _, ok := d.GetOk("policies"); ok {
+		d.Set("policies", data["policies"])
+	} else {
+		d.Set("consul_policies", data["policies"])
+	}
+	d.Set("consul_roles", data["consul_roles"])
+	d.Set("service_identities", data["service_identities"])
+	d.Set("node_identities", data["node_identities"])
+
+	var policy string
+	if v, ok := data["policy"].(string); ok && v != "" {
+		decoded, err := base64.StdEncoding.DecodeString(v)
+		if err != nil {
+			return fmt.Errorf("error decoding policy for %q: %s", path, err)
+		}
+		policy = string(decoded)
+	}
+	d.Set("policy", policy)
 	d.Set("max_ttl", data["max_ttl"])
 	d.Set("ttl", data["ttl"])
 	d.Set("token_type", data["token_type"])
-	d.Set("local", data["local"])
+	if v, ok := data["local"]; ok {
+		d.Set("local", v)
+	}
 
 	return nil
 }
@@ -186,6 +298,19 @@ func consulSecretBackendRoleDelete(d *schema.ResourceData, meta interface{}) err
 
 	path := d.Id()
 
+	if d.Get("revoke_on_delete").(bool) {
+		prefix, err := consulSecretBackendRoleLeasePrefix(path)
+		if err != nil {
+			return fmt.Errorf("error determining lease prefix for Consul backend role at %q: %s", path, err)
+		}
+
+		log.Printf("[DEBUG] Revoking leases under %q", prefix)
+		if err := client.Sys().RevokePrefix(prefix); err != nil {
+			return fmt.Errorf("error revoking leases under %q: %s", prefix, err)
+		}
+		log.Printf("[DEBUG] Revoked leases under %q", prefix)
+	}
+
 	log.Printf("[DEBUG] Deleting Consul backend role at %q", path)
 
 	if _, err := client.Logical().Delete(path); err != nil {
@@ -229,6 +354,18 @@ func consulSecretBackendRolePath(backend, name string) string {
 	return strings.Trim(backend, "/") + "/roles/" + name
 }
 
+func consulSecretBackendRoleLeasePrefix(path string) (string, error) {
+	backend, err := consulSecretBackendRoleBackendFromPath(path)
+	if err != nil {
+		return "", err
+	}
+	name, err := consulSecretBackendRoleNameFromPath(path)
+	if err != nil {
+		return "", err
+	}
+	return strings.Trim(backend, "/") + "/creds/" + name, nil
+}
+
 func consulSecretBackendRoleNameFromPath(path string) (string, error) {
 	if !consulSecretBackendRoleNameFromPathRegex.MatchString(path) {
 		return "", fmt.Errorf("no name found")
@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
@@ -39,6 +40,7 @@ func TestConsulSecretBackendRole(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_consul_secret_backend_role.test", "max_ttl", "240"),
 					resource.TestCheckResourceAttr("vault_consul_secret_backend_role.test", "local", "true"),
 					resource.TestCheckResourceAttr("vault_consul_secret_backend_role.test", "token_type", "client"),
+					resource.TestCheckResourceAttr("vault_consul_secret_backend_role.test", "revoke_on_delete", "true"),
 					resource.TestCheckResourceAttr("vault_consul_secret_backend_role.test", "policies.0", "foo"),
 					resource.TestCheckResourceAttr("vault_consul_secret_backend_role.test", "policies.1", "bar"),
 					resource.TestCheckResourceAttr("vault_consul_secret_backend_role.test_path", "path", backend),
@@ -49,6 +51,56 @@ func TestConsulSecretBackendRole(t *testing.T) {
 	})
 }
 
+func TestConsulSecretBackendRole_consulPolicies(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-backend")
+	name := acctest.RandomWithPrefix("tf-test-name")
+	token := "026a0c16-87cd-4c2d-b3f3-fb539f592b7e"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccConsulSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testConsulSecretBackendRole_consulPoliciesConfig(backend, name, token),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_consul_secret_backend_role.test", "consul_policies.#", "2"),
+					resource.TestCheckResourceAttr("vault_consul_secret_backend_role.test", "consul_policies.0", "foo"),
+					resource.TestCheckResourceAttr("vault_consul_secret_backend_role.test", "consul_policies.1", "bar"),
+					resource.TestCheckResourceAttr("vault_consul_secret_backend_role.test", "consul_roles.#", "1"),
+					resource.TestCheckResourceAttr("vault_consul_secret_backend_role.test", "consul_roles.0", "baz"),
+					resource.TestCheckResourceAttr("vault_consul_secret_backend_role.test", "policies.#", "0"),
+					resource.TestCheckResourceAttr("vault_consul_secret_backend_role.test_inline", "policy", "key \"\" { policy = \"read\" }"),
+				),
+			},
+		},
+	})
+}
+
+func TestConsulSecretBackendRole_grantSource(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-backend")
+	name := acctest.RandomWithPrefix("tf-test-name")
+	token := "026a0c16-87cd-4c2d-b3f3-fb539f592b7e"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccConsulSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testConsulSecretBackendRole_grantSourceConfig(backend, name, token, ""),
+				ExpectError: regexp.MustCompile(`at least one of policy, policies, consul_policies, consul_roles, service_identities, node_identities must be set`),
+			},
+			{
+				Config: testConsulSecretBackendRole_grantSourceConfig(backend, name, token, `service_identities = ["web:dc1,dc2"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_consul_secret_backend_role.test", "service_identities.#", "1"),
+					resource.TestCheckResourceAttr("vault_consul_secret_backend_role.test", "service_identities.0", "web:dc1,dc2"),
+					resource.TestCheckResourceAttr("vault_consul_secret_backend_role.test", "local", "true"),
+				),
+			},
+		},
+	})
+}
+
 func testAccConsulSecretBackendRoleCheckDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -120,6 +172,7 @@ resource "vault_consul_secret_backend_role" "test" {
   max_ttl = 240
   local = true
   token_type = "client"
+  revoke_on_delete = true
 }
 resource "vault_consul_secret_backend_role" "test_path" {
   path = vault_consul_secret_backend.test.path
@@ -133,6 +186,80 @@ resource "vault_consul_secret_backend_role" "test_path" {
 `, backend, token, name)
 }
 
+func testConsulSecretBackendRole_consulPoliciesConfig(backend, name, token string) string {
+	return fmt.Sprintf(`
+resource "vault_consul_secret_backend" "test" {
+  path = "%s"
+  description = "test description"
+  default_lease_ttl_seconds = 3600
+  max_lease_ttl_seconds = 86400
+  address = "127.0.0.1:8500"
+  token = "%s"
+}
+
+resource "vault_consul_secret_backend_role" "test" {
+  backend = vault_consul_secret_backend.test.path
+  name = "%s"
+
+  consul_policies = [
+    "foo",
+    "bar",
+  ]
+  consul_roles = [
+    "baz",
+  ]
+}
+
+resource "vault_consul_secret_backend_role" "test_inline" {
+  backend = vault_consul_secret_backend.test.path
+  name = "%[3]s_inline"
+  policy = "key \"\" { policy = \"read\" }"
+}
+`, backend, token, name)
+}
+
+func testConsulSecretBackendRole_grantSourceConfig(backend, name, token, grant string) string {
+	return fmt.Sprintf(`
+resource "vault_consul_secret_backend" "test" {
+  path = "%s"
+  description = "test description"
+  address = "127.0.0.1:8500"
+  token = "%s"
+}
+
+resource "vault_consul_secret_backend_role" "test" {
+  backend = vault_consul_secret_backend.test.path
+  name = "%s"
+  local = true
+  %s
+}
+`, backend, token, name, grant)
+}
+
+func TestConsulSecretBackendRole_inlinePolicyConflicts(t *testing.T) {
+	for _, k := range []string{"policies", "consul_policies"} {
+		config := map[string]interface{}{
+			"name":    "test",
+			"backend": "consul",
+			"policy":  `key "" { policy = "read" }`,
+			k:         []interface{}{"foo"},
+		}
+		_, errs := consulSecretBackendRoleResource().Validate(terraform.NewResourceConfigRaw(config))
+		if len(errs) == 0 {
+			t.Errorf("expected policy and %s to conflict", k)
+		}
+	}
+
+	config := map[string]interface{}{
+		"name":    "test",
+		"backend": "consul",
+		"policy":  `key "" { policy = "read" }`,
+	}
+	if _, errs := consulSecretBackendRoleResource().Validate(terraform.NewResourceConfigRaw(config)); len(errs) > 0 {
+		t.Errorf("expected an inline policy alone to be valid, got %v", errs)
+	}
+}
+
 func TestConsulSecretBackendRoleNameFromPath(t *testing.T) {
 	{
 		name, err := consulSecretBackendRoleNameFromPath("foo/roles/bar")
@@ -155,6 +282,20 @@ func TestConsulSecretBackendRoleNameFromPath(t *testing.T) {
 	}
 }
 
+func TestConsulSecretBackendRoleLeasePrefix(t *testing.T) {
+	prefix, err := consulSecretBackendRoleLeasePrefix("foo/roles/bar")
+	if err != nil {
+		t.Fatalf("error getting lease prefix: %v", err)
+	}
+	if prefix != "foo/creds/bar" {
+		t.Fatalf("expected lease prefix 'foo/creds/bar', but got %s", prefix)
+	}
+
+	if _, err := consulSecretBackendRoleLeasePrefix("no match"); err == nil {
+		t.Fatal("Expected error getting lease prefix but got nil")
+	}
+}
+
 func TestConsulSecretBackendRoleBackendFromPath(t *testing.T) {
 	{
 		backend, err := consulSecretBackendRoleBackendFromPath("foo/roles/bar")
@@ -0,0 +1,68 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+const controlGroupAuthorizePath = "sys/control-group/authorize"
+
+func controlGroupAuthorizationResource() *schema.Resource {
+	return &schema.Resource{
+		Create: controlGroupAuthorizationCreate,
+		Read:   controlGroupAuthorizationRead,
+		Delete: controlGroupAuthorizationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"accessor": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Accessor of the wrapping token of the control group request to authorize.",
+			},
+			"approved": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether the request was approved once authorized.",
+			},
+		},
+	}
+}
+
+func controlGroupAuthorizationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	accessor := d.Get("accessor").(string)
+
+	log.Printf("[DEBUG] Authorizing control group request %q", accessor)
+	resp, err := client.Logical().Write(controlGroupAuthorizePath, map[string]interface{}{
+		"accessor": accessor,
+	})
+	if err != nil {
+		return fmt.Errorf("error authorizing control group request %q: %s", accessor, err)
+	}
+	log.Printf("[DEBUG] Authorized control group request %q", accessor)
+
+	d.SetId(accessor)
+	if resp != nil {
+		d.Set("approved", resp.Data["approved"])
+	}
+
+	return controlGroupAuthorizationRead(d, meta)
+}
+
+func controlGroupAuthorizationRead(d *schema.ResourceData, meta interface{}) error {
+	// The request is gone once its wrapping token has been unwrapped, so the
+	// authorization is kept as it was when it was made.
+	return nil
+}
+
+func controlGroupAuthorizationDelete(d *schema.ResourceData, meta interface{}) error {
+	// An authorization can't be revoked, destroying it only removes it from
+	// state.
+	log.Printf("[DEBUG] Removing control group authorization %q from state", d.Id())
+	return nil
+}
@@ -0,0 +1,30 @@
+package vault
+
+import (
+	"os"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccControlGroupAuthorization_unknownAccessor(t *testing.T) {
+	if os.Getenv("TF_ACC_ENTERPRISE") == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: `
+resource "vault_control_group_authorization" "test" {
+  accessor = "does-not-exist"
+}
+`,
+				ExpectError: regexp.MustCompile(`error authorizing control group request "does-not-exist"`),
+			},
+		},
+	})
+}
@@ -0,0 +1,186 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"net/http"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+const corsConfigPath = "sys/config/cors"
+
+// corsConfigStdHeaders are the headers Vault always allows and adds to the
+// configured allowed_headers.
+var corsConfigStdHeaders = []string{
+	"Authorization",
+	"Content-Type",
+	"X-Requested-With",
+	"X-Vault-Aws-Iam-Server-Id",
+	"X-Vault-Mfa",
+	"X-Vault-Namespace",
+	"X-Vault-No-Request-Forwarding",
+	"X-Vault-Policy-Override",
+	"X-Vault-Token",
+	"X-Vault-Wrap-Format",
+	"X-Vault-Wrap-Ttl",
+}
+
+func corsConfigResource() *schema.Resource {
+	return &schema.Resource{
+		Create: corsConfigWrite,
+		Read:   corsConfigRead,
+		Update: corsConfigWrite,
+		Delete: corsConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"enabled": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     true,
+				Description: "Whether CORS is enabled.",
+			},
+			"allowed_origins": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Origins allowed to make cross-origin requests, * allows all of them.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"allowed_headers": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Headers allowed in cross-origin requests, in addition to the ones Vault always allows.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+		CustomizeDiff: corsConfigCustomizeDiff,
+	}
+}
+
+// corsConfigCustomizeDiff rejects enabling CORS without any allowed origin,
+// which Vault would only reject on apply.
+func corsConfigCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.Get("enabled").(bool) || !d.NewValueKnown("allowed_origins") {
+		return nil
+	}
+
+	if d.Get("allowed_origins").(*schema.Set).Len() == 0 {
+		return fmt.Errorf("allowed_origins must be set when enabled is true")
+	}
+	return nil
+}
+
+func corsConfigWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	d.SetId(corsConfigPath)
+
+	// Vault enables CORS when it's configured and disables it when the
+	// config is deleted.
+	if !d.Get("enabled").(bool) {
+		log.Printf("[DEBUG] Disabling CORS")
+		if _, err := client.Logical().Delete(corsConfigPath); err != nil {
+			return fmt.Errorf("error disabling CORS: %s", err)
+		}
+		log.Printf("[DEBUG] Disabled CORS")
+		return corsConfigRead(d, meta)
+	}
+
+	data := map[string]interface{}{
+		"allowed_origins": util.TerraformSetToStringArray(d.Get("allowed_origins")),
+		"allowed_headers": util.TerraformSetToStringArray(d.Get("allowed_headers")),
+	}
+
+	log.Printf("[DEBUG] Writing CORS config")
+	if _, err := client.Logical().Write(corsConfigPath, data); err != nil {
+		return fmt.Errorf("error writing CORS config: %s", err)
+	}
+	log.Printf("[DEBUG] Wrote CORS config")
+
+	return corsConfigRead(d, meta)
+}
+
+func corsConfigRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	log.Printf("[DEBUG] Reading CORS config")
+	resp, err := client.Logical().Read(corsConfigPath)
+	if err != nil {
+		return fmt.Errorf("error reading CORS config: %s", err)
+	}
+	log.Printf("[DEBUG] Read CORS config")
+	if resp == nil {
+		log.Printf("[WARN] CORS config not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+
+	enabled, _ := resp.Data["enabled"].(bool)
+	d.Set("enabled", enabled)
+
+	// A disabled config has no origins or headers, keep the configured ones
+	// so they're used once it's enabled again.
+	if !enabled {
+		return nil
+	}
+
+	if err := d.Set("allowed_origins", resp.Data["allowed_origins"]); err != nil {
+		return fmt.Errorf("error setting allowed_origins for CORS config: %s", err)
+	}
+
+	headers := corsConfigFilterHeaders(resp.Data["allowed_headers"], util.TerraformSetToStringArray(d.Get("allowed_headers")))
+	if err := d.Set("allowed_headers", headers); err != nil {
+		return fmt.Errorf("error setting allowed_headers for CORS config: %s", err)
+	}
+
+	return nil
+}
+
+func corsConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	log.Printf("[DEBUG] Disabling CORS")
+	if _, err := client.Logical().Delete(corsConfigPath); err != nil {
+		return fmt.Errorf("error disabling CORS: %s", err)
+	}
+	log.Printf("[DEBUG] Disabled CORS")
+
+	return nil
+}
+
+// corsConfigFilterHeaders drops the headers Vault always allows from the ones
+// it returns, unless they were configured.
+func corsConfigFilterHeaders(v interface{}, configured []string) []string {
+	keep := map[string]bool{}
+	for _, h := range configured {
+		keep[http.CanonicalHeaderKey(h)] = true
+	}
+	std := map[string]bool{}
+	for _, h := range corsConfigStdHeaders {
+		std[h] = true
+	}
+
+	raw, _ := v.([]interface{})
+	headers := make([]string, 0, len(raw))
+	for _, r := range raw {
+		h, ok := r.(string)
+		if !ok {
+			continue
+		}
+		key := http.CanonicalHeaderKey(h)
+		if std[key] && !keep[key] {
+			continue
+		}
+		headers = append(headers, h)
+	}
+	return headers
+}
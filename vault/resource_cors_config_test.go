@@ -0,0 +1,107 @@
+package vault
+
+import (
+	"fmt"
+	"reflect"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccCORSConfig(t *testing.T) {
+	resourceName := "vault_cors_config.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCORSConfigCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccCORSConfigConfig(true, `[]`, `[]`),
+				ExpectError: regexp.MustCompile("allowed_origins must be set when enabled is true"),
+			},
+			{
+				Config: testAccCORSConfigConfig(true, `["https://app.example.com"]`, `["X-Custom-Header"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", corsConfigPath),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_origins.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_headers.#", "1"),
+				),
+			},
+			{
+				Config: testAccCORSConfigConfig(false, `["https://app.example.com"]`, `["X-Custom-Header"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "false"),
+					testAccCORSConfigCheckEnabled(false),
+				),
+			},
+			{
+				Config: testAccCORSConfigConfig(true, `["*"]`, `[]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_origins.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_headers.#", "0"),
+					testAccCORSConfigCheckEnabled(true),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestCORSConfigFilterHeaders(t *testing.T) {
+	returned := []interface{}{"Content-Type", "X-Vault-Token", "X-Custom-Header", "authorization"}
+
+	tests := []struct {
+		configured []string
+		expected   []string
+	}{
+		{nil, []string{"X-Custom-Header"}},
+		{[]string{"X-Custom-Header"}, []string{"X-Custom-Header"}},
+		{[]string{"x-vault-token"}, []string{"X-Vault-Token", "X-Custom-Header"}},
+		{[]string{"Authorization"}, []string{"X-Custom-Header", "authorization"}},
+	}
+
+	for _, test := range tests {
+		if actual := corsConfigFilterHeaders(returned, test.configured); !reflect.DeepEqual(actual, test.expected) {
+			t.Errorf("configured %v: expected %v, got %v", test.configured, test.expected, actual)
+		}
+	}
+}
+
+func testAccCORSConfigCheckEnabled(expected bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testProvider.Meta().(*api.Client)
+
+		resp, err := client.Logical().Read(corsConfigPath)
+		if err != nil {
+			return err
+		}
+		if enabled, _ := resp.Data["enabled"].(bool); enabled != expected {
+			return fmt.Errorf("expected CORS enabled to be %t, got %t", expected, enabled)
+		}
+		return nil
+	}
+}
+
+func testAccCORSConfigCheckDestroy(s *terraform.State) error {
+	return testAccCORSConfigCheckEnabled(false)(s)
+}
+
+func testAccCORSConfigConfig(enabled bool, origins, headers string) string {
+	return fmt.Sprintf(`
+resource "vault_cors_config" "test" {
+  enabled         = %t
+  allowed_origins = %s
+  allowed_headers = %s
+}
+`, enabled, origins, headers)
+}
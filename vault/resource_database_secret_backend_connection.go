@@ -65,6 +65,19 @@ func databaseSecretBackendConnectionResource() *schema.Resource {
 				Description: "A map of sensitive data to pass to the endpoint. Useful for templated connection strings.",
 				Sensitive:   true,
 			},
+			"password_policy": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name of the password policy to use when generating passwords for this database. If not set, the plugin's default policy is used.",
+			},
+			"rotate_root": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Arbitrary map of values that, when set or changed, rotates the root credentials of the connection. Vault owns the new password and the original one stops working.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 
 			"elasticsearch": {
 				Type:        schema.TypeList,
@@ -557,6 +570,12 @@ func databaseSecretBackendConnectionCreate(d *schema.ResourceData, meta interfac
 		data["root_rotation_statements"] = v
 	}
 
+	if v, ok := d.GetOk("password_policy"); ok {
+		data["password_policy"] = v.(string)
+	} else if d.HasChange("password_policy") {
+		data["password_policy"] = ""
+	}
+
 	if m, ok := d.GetOkExists("data"); ok {
 		for k, v := range m.(map[string]interface{}) {
 			data[k] = v.(string)
@@ -572,6 +591,12 @@ func databaseSecretBackendConnectionCreate(d *schema.ResourceData, meta interfac
 	d.SetId(path)
 	log.Printf("[DEBUG] Wrote database connection config %q", path)
 
+	if len(d.Get("rotate_root").(map[string]interface{})) > 0 {
+		if err := databaseSecretBackendConnectionRotateRoot(client, backend, name); err != nil {
+			return err
+		}
+	}
+
 	return databaseSecretBackendConnectionRead(d, meta)
 }
 
@@ -715,6 +740,9 @@ func databaseSecretBackendConnectionRead(d *schema.ResourceData, meta interface{
 	if v, ok := resp.Data["verify_connection"]; ok {
 		d.Set("verify_connection", v.(bool))
 	}
+	if v, ok := resp.Data["password_policy"]; ok {
+		d.Set("password_policy", v)
+	}
 
 	return nil
 }
@@ -748,17 +776,34 @@ func databaseSecretBackendConnectionUpdate(d *schema.ResourceData, meta interfac
 		data["root_rotation_statements"] = v
 	}
 
+	if v, ok := d.GetOk("password_policy"); ok {
+		data["password_policy"] = v.(string)
+	} else if d.HasChange("password_policy") {
+		data["password_policy"] = ""
+	}
+
 	if m, ok := d.GetOkExists("data"); ok {
 		for k, v := range m.(map[string]interface{}) {
 			// Vault does not return the password in the API. If the root credentials have been rotated, sending
 			// the old password in the update request would break the connection config. Thus we only send it,
 			// if it actually changed, to still support updating it for non-rotated cases.
-			if k == "password" && d.HasChange(k) {
+			if k == "password" && d.HasChange("data.password") {
 				data[k] = v.(string)
 			}
 		}
 	}
 
+	// The same goes for the plugin passwords once the root credentials have
+	// been rotated through rotate_root: Vault owns the password from then on,
+	// so the original one is only sent again when it's explicitly changed.
+	if len(d.Get("rotate_root").(map[string]interface{})) > 0 {
+		for _, k := range []string{"cassandra.0.password", "elasticsearch.0.password"} {
+			if _, ok := d.GetOk(k); ok && !d.HasChange(k) {
+				delete(data, "password")
+			}
+		}
+	}
+
 	log.Printf("[DEBUG] Writing connection config to %q", path)
 	_, err = client.Logical().Write(path, data)
 
@@ -767,6 +812,15 @@ func databaseSecretBackendConnectionUpdate(d *schema.ResourceData, meta interfac
 	}
 	log.Printf("[DEBUG] Wrote database connection config %q", path)
 
+	// Rotating only when the triggers change keeps the provider from rotating
+	// on every apply, and the rotation has to follow the write so that Vault
+	// rotates the credentials it was just given.
+	if d.HasChange("rotate_root") && len(d.Get("rotate_root").(map[string]interface{})) > 0 {
+		if err := databaseSecretBackendConnectionRotateRoot(client, backend, name); err != nil {
+			return err
+		}
+	}
+
 	return databaseSecretBackendConnectionRead(d, meta)
 }
 
@@ -798,6 +852,18 @@ func databaseSecretBackendConnectionExists(d *schema.ResourceData, meta interfac
 	return resp != nil, nil
 }
 
+func databaseSecretBackendConnectionRotateRoot(client *api.Client, backend, name string) error {
+	path := strings.Trim(backend, "/") + "/rotate-root/" + strings.Trim(name, "/")
+
+	log.Printf("[DEBUG] Rotating root credentials with %q", path)
+	if _, err := client.Logical().Write(path, nil); err != nil {
+		return fmt.Errorf("error rotating root credentials with %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Rotated root credentials with %q", path)
+
+	return nil
+}
+
 func databaseSecretBackendConnectionPath(backend, name string) string {
 	return strings.Trim(backend, "/") + "/config/" + strings.Trim(name, "/")
 }
@@ -460,6 +460,104 @@ func TestAccDatabaseSecretBackendConnectionTemplatedUpdateExcludePassword_mysql(
 	})
 }
 
+func TestAccDatabaseSecretBackendConnectionRotateRoot_mysql(t *testing.T) {
+	connURL := os.Getenv("MYSQL_CONNECTION_URL")
+	if connURL == "" {
+		t.Skip("MYSQL_CONNECTION_URL not set")
+	}
+	username := os.Getenv("MYSQL_CONNECTION_USERNAME")
+	if username == "" {
+		t.Skip("MYSQL_CONNECTION_USERNAME not set")
+	}
+	password := os.Getenv("MYSQL_CONNECTION_PASSWORD")
+	if password == "" {
+		t.Skip("MYSQL_CONNECTION_PASSWORD not set")
+	}
+
+	// See TestAccDatabaseSecretBackendConnectionTemplatedUpdateExcludePassword_mysql
+	// for the difference between the URLs.
+	testConnURL := os.Getenv("MYSQL_TEMPLATED_URL")
+	if testConnURL == "" {
+		testConnURL = connURL
+	}
+
+	backend := acctest.RandomWithPrefix("tf-test-db")
+	name := acctest.RandomWithPrefix("db")
+	testUsername := acctest.RandomWithPrefix("username")
+	testPassword := acctest.RandomWithPrefix("password")
+	resourceName := "vault_database_secret_backend_connection.test"
+
+	db := newMySQLConnection(t, connURL, username, password)
+	createMySQSUser(t, db, testUsername, testPassword)
+	defer deleteMySQLUser(t, db, testUsername)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccDatabaseSecretBackendConnectionCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseSecretBackendConnectionConfigRotateRoot_mysql(name, backend, testConnURL, testUsername, testPassword, "2021-01", 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "password_policy", name),
+					resource.TestCheckResourceAttr(resourceName, "rotate_root.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "rotate_root.period", "2021-01"),
+					testAccDatabaseSecretBackendConnectionCheckPasswordRotated(connURL, testUsername, testPassword),
+				),
+			},
+			{
+				// Updating the connection must keep the rotated password.
+				Config: testAccDatabaseSecretBackendConnectionConfigRotateRoot_mysql(name, backend, testConnURL, testUsername, testPassword, "2021-01", 10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "mysql.0.max_connection_lifetime", "10"),
+					testAccDatabaseSecretBackendConnectionCheckCredentials(backend, name),
+				),
+			},
+			{
+				Config: testAccDatabaseSecretBackendConnectionConfigRotateRoot_mysql(name, backend, testConnURL, testUsername, testPassword, "2021-02", 10),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rotate_root.period", "2021-02"),
+					testAccDatabaseSecretBackendConnectionCheckCredentials(backend, name),
+				),
+			},
+		},
+	})
+}
+
+// testAccDatabaseSecretBackendConnectionCheckPasswordRotated checks that the
+// original password no longer works.
+func testAccDatabaseSecretBackendConnectionCheckPasswordRotated(connURL, username, password string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		db, err := sql.Open("mysql", dbutil.QueryHelper(connURL, map[string]string{
+			"username": username,
+			"password": password,
+		}))
+		if err != nil {
+			return err
+		}
+		defer db.Close()
+
+		if err := db.Ping(); err == nil {
+			return fmt.Errorf("expected the original password of %q to be rotated, and it still works", username)
+		}
+		return nil
+	}
+}
+
+// testAccDatabaseSecretBackendConnectionCheckCredentials checks that Vault can
+// still connect to the database, by rotating the root credentials once more.
+func testAccDatabaseSecretBackendConnectionCheckCredentials(backend, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testProvider.Meta().(*api.Client)
+
+		path := fmt.Sprintf("%s/rotate-root/%s", backend, name)
+		if _, err := client.Logical().Write(path, nil); err != nil {
+			return fmt.Errorf("expected Vault to still connect with the rotated password: %s", err)
+		}
+		return nil
+	}
+}
+
 func TestAccDatabaseSecretBackendConnection_postgresql(t *testing.T) {
 	connURL := os.Getenv("POSTGRES_URL")
 	if connURL == "" {
@@ -828,6 +926,47 @@ resource "vault_database_secret_backend_connection" "test" {
 `, path, name, connURL)
 }
 
+func testAccDatabaseSecretBackendConnectionConfigRotateRoot_mysql(name, path, connURL, username, password, period string, connLifetime int) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "db" {
+  path = "%s"
+  type = "database"
+}
+
+resource "vault_password_policy" "test" {
+  name = "%s"
+
+  policy = <<EOT
+length = 20
+rule "charset" {
+  charset = "abcdefghijklmnopqrstuvwxyz0123456789"
+}
+EOT
+}
+
+resource "vault_database_secret_backend_connection" "test" {
+  backend         = vault_mount.db.path
+  name            = "%s"
+  allowed_roles   = ["dev", "prod"]
+  password_policy = vault_password_policy.test.name
+
+  mysql {
+	  connection_url          = "%s"
+	  max_connection_lifetime = "%d"
+  }
+
+  data = {
+	  username = "%s"
+	  password = "%s"
+  }
+
+  rotate_root = {
+	  period = "%s"
+  }
+}
+`, path, name, name, connURL, connLifetime, username, password, period)
+}
+
 func newMySQLConnection(t *testing.T, connURL string, username string, password string) *sql.DB {
 	dbURL := dbutil.QueryHelper(connURL, map[string]string{
 		"username": username,
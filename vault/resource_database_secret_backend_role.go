@@ -8,10 +8,15 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
 	"github.com/hashicorp/vault/api"
 )
 
 var (
+	// databaseSecretBackendRoleNameTemplates are the templates Vault replaces
+	// with the generated username; one of them must appear in the statements.
+	databaseSecretBackendRoleNameTemplates = []string{"{{name}}", "{{username}}"}
+
 	databaseSecretBackendRoleBackendFromPathRegex = regexp.MustCompile("^(.+)/roles/.+$")
 	databaseSecretBackendRoleNameFromPathRegex    = regexp.MustCompile("^.+/roles/(.+$)")
 )
@@ -81,7 +86,61 @@ func databaseSecretBackendRoleResource() *schema.Resource {
 				Description: "Database statements to execute to renew a user.",
 			},
 		},
+		CustomizeDiff: databaseSecretBackendRoleCustomizeDiff,
+	}
+}
+
+// databaseSecretBackendRoleCustomizeDiff catches creation statements that
+// would only fail once Vault tries to generate credentials for the role.
+func databaseSecretBackendRoleCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("creation_statements") {
+		return nil
+	}
+
+	statements := util.ToStringArray(d.Get("creation_statements").([]interface{}))
+	warnings, err := databaseSecretBackendRoleCheckCreationStatements(statements)
+	if err != nil {
+		return err
+	}
+	for _, w := range warnings {
+		log.Printf("[WARN] Database role %q: %s", d.Get("name"), w)
+	}
+	return nil
+}
+
+// databaseSecretBackendRoleCheckCreationStatements checks that templated
+// creation statements reference the generated username, and warns when they
+// don't reference the generated password. Plugins such as MongoDB and
+// Elasticsearch take a JSON document instead, which isn't checked.
+func databaseSecretBackendRoleCheckCreationStatements(statements []string) ([]string, error) {
+	if len(statements) == 0 {
+		return nil, nil
+	}
+	for _, statement := range statements {
+		var doc map[string]interface{}
+		if err := json.Unmarshal([]byte(statement), &doc); err == nil {
+			return nil, nil
+		}
+	}
+
+	joined := strings.Join(statements, "\n")
+	hasName := false
+	for _, tmpl := range databaseSecretBackendRoleNameTemplates {
+		if strings.Contains(joined, tmpl) {
+			hasName = true
+			break
+		}
+	}
+	if !hasName {
+		return nil, fmt.Errorf("creation_statements must contain one of %s to create the generated user",
+			strings.Join(databaseSecretBackendRoleNameTemplates, ", "))
+	}
+
+	var warnings []string
+	if !strings.Contains(joined, "{{password}}") {
+		warnings = append(warnings, "creation_statements don't contain {{password}}, so the generated password will not be set")
 	}
+	return warnings, nil
 }
 
 func databaseSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) error {
@@ -32,7 +32,7 @@ func TestAccDatabaseSecretBackendRole_import(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_database_secret_backend_role.test", "db_name", dbName),
 					resource.TestCheckResourceAttr("vault_database_secret_backend_role.test", "default_ttl", "3600"),
 					resource.TestCheckResourceAttr("vault_database_secret_backend_role.test", "max_ttl", "7200"),
-					resource.TestCheckResourceAttr("vault_database_secret_backend_role.test", "creation_statements.0", "SELECT 1;"),
+					resource.TestCheckResourceAttr("vault_database_secret_backend_role.test", "creation_statements.0", "CREATE USER '{{name}}'@'%' IDENTIFIED BY '{{password}}';"),
 				),
 			},
 			{
@@ -66,7 +66,7 @@ func TestAccDatabaseSecretBackendRole_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_database_secret_backend_role.test", "db_name", dbName),
 					resource.TestCheckResourceAttr("vault_database_secret_backend_role.test", "default_ttl", "3600"),
 					resource.TestCheckResourceAttr("vault_database_secret_backend_role.test", "max_ttl", "7200"),
-					resource.TestCheckResourceAttr("vault_database_secret_backend_role.test", "creation_statements.0", "SELECT 1;"),
+					resource.TestCheckResourceAttr("vault_database_secret_backend_role.test", "creation_statements.0", "CREATE USER '{{name}}'@'%' IDENTIFIED BY '{{password}}';"),
 				),
 			},
 			{
@@ -77,7 +77,7 @@ func TestAccDatabaseSecretBackendRole_basic(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_database_secret_backend_role.test", "db_name", dbName),
 					resource.TestCheckResourceAttr("vault_database_secret_backend_role.test", "default_ttl", "1800"),
 					resource.TestCheckResourceAttr("vault_database_secret_backend_role.test", "max_ttl", "3600"),
-					resource.TestCheckResourceAttr("vault_database_secret_backend_role.test", "creation_statements.0", "SELECT 1;"),
+					resource.TestCheckResourceAttr("vault_database_secret_backend_role.test", "creation_statements.0", "CREATE USER '{{name}}'@'%' IDENTIFIED BY '{{password}}';"),
 				),
 			},
 		},
@@ -125,7 +125,7 @@ resource "vault_database_secret_backend_role" "test" {
   name = "%s"
   default_ttl = 3600
   max_ttl = 7200
-  creation_statements = ["SELECT 1;"]
+  creation_statements = ["CREATE USER '{{name}}'@'%%' IDENTIFIED BY '{{password}}';"]
 }
 `, path, db, connURL, name)
 }
@@ -153,7 +153,53 @@ resource "vault_database_secret_backend_role" "test" {
   name = "%s"
   default_ttl = 1800
   max_ttl = 3600
-  creation_statements = ["SELECT 1;"]
+  creation_statements = ["CREATE USER '{{name}}'@'%%' IDENTIFIED BY '{{password}}';"]
 }
 `, path, db, connURL, name)
 }
+
+func TestDatabaseSecretBackendRoleCheckCreationStatements(t *testing.T) {
+	testCases := []struct {
+		name       string
+		statements []string
+		warnings   int
+		err        bool
+	}{
+		{
+			name:       "name and password",
+			statements: []string{"CREATE ROLE \"{{name}}\" WITH LOGIN PASSWORD '{{password}}' VALID UNTIL '{{expiration}}';"},
+		},
+		{
+			name:       "templates across statements",
+			statements: []string{"CREATE USER '{{username}}'@'%' IDENTIFIED BY '{{password}}';", "GRANT SELECT ON *.* TO '{{username}}'@'%';"},
+		},
+		{
+			name:       "missing password",
+			statements: []string{"CREATE USER '{{name}}'@'%';"},
+			warnings:   1,
+		},
+		{
+			name:       "missing name",
+			statements: []string{"SELECT 1;"},
+			err:        true,
+		},
+		{
+			name:       "JSON document",
+			statements: []string{`{"db": "admin", "roles": [{"role": "readWrite"}]}`},
+		},
+	}
+	for _, testCase := range testCases {
+		t.Run(testCase.name, func(t *testing.T) {
+			warnings, err := databaseSecretBackendRoleCheckCreationStatements(testCase.statements)
+			if testCase.err && err == nil {
+				t.Fatal("expected an error")
+			}
+			if !testCase.err && err != nil {
+				t.Fatalf("unexpected error: %s", err)
+			}
+			if len(warnings) != testCase.warnings {
+				t.Fatalf("expected %d warnings but received %v", testCase.warnings, warnings)
+			}
+		})
+	}
+}
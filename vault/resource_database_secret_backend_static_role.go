@@ -8,6 +8,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/vault/api"
 )
 
@@ -47,9 +48,10 @@ func databaseSecretBackendStaticRoleResource() *schema.Resource {
 				Description: "The database username that this role corresponds to.",
 			},
 			"rotation_period": {
-				Type:        schema.TypeInt,
-				Required:    true,
-				Description: "The amount of time Vault should wait before rotating the password, in seconds.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ExactlyOneOf: []string{"rotation_period", "rotation_schedule"},
+				Description:  "The amount of time Vault should wait before rotating the password, in seconds.",
 				ValidateFunc: func(v interface{}, k string) (ws []string, errs []error) {
 					value := v.(int)
 					if value < 5 {
@@ -58,6 +60,20 @@ func databaseSecretBackendStaticRoleResource() *schema.Resource {
 					return
 				},
 			},
+			"rotation_schedule": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: []string{"rotation_period", "rotation_schedule"},
+				Description:  "A cron-style schedule for rotating the password, e.g. \"0 0 * * SAT\". Requires Vault 1.15 or later.",
+				ValidateFunc: validateCronSchedule,
+			},
+			"rotation_window": {
+				Type:          schema.TypeInt,
+				Optional:      true,
+				ConflictsWith: []string{"rotation_period"},
+				Description:   "The amount of time, in seconds, in which a scheduled rotation may still happen once its time has passed. Only used with rotation_schedule.",
+				ValidateFunc:  validation.IntAtLeast(3600),
+			},
 			"db_name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -84,11 +100,21 @@ func databaseSecretBackendStaticRoleWrite(d *schema.ResourceData, meta interface
 
 	data := map[string]interface{}{
 		"username":            d.Get("username"),
-		"rotation_period":     d.Get("rotation_period"),
 		"db_name":             d.Get("db_name"),
 		"rotation_statements": []string{},
 	}
 
+	// Only one of the rotation settings is sent, since Vault refuses a
+	// period and a schedule together.
+	if v, ok := d.GetOk("rotation_schedule"); ok {
+		data["rotation_schedule"] = v
+		if v, ok := d.GetOk("rotation_window"); ok {
+			data["rotation_window"] = v
+		}
+	} else {
+		data["rotation_period"] = d.Get("rotation_period")
+	}
+
 	if v, ok := d.GetOkExists("rotation_statements"); ok && v != "" {
 		data["rotation_statements"] = v
 	}
@@ -140,13 +166,19 @@ func databaseSecretBackendStaticRoleRead(d *schema.ResourceData, meta interface{
 	d.Set("username", role.Data["username"])
 	d.Set("db_name", role.Data["db_name"])
 
-	if v, ok := role.Data["rotation_period"]; ok {
-		n, err := v.(json.Number).Int64()
-		if err != nil {
-			return fmt.Errorf("unexpected value %q for rotation_period of %q", v, path)
+	// Vault only returns the rotation settings that are in use, so the others
+	// are cleared when switching between a period and a schedule.
+	for _, k := range []string{"rotation_period", "rotation_window"} {
+		var n int64
+		if v, ok := role.Data[k]; ok && v != nil {
+			n, err = v.(json.Number).Int64()
+			if err != nil {
+				return fmt.Errorf("unexpected value %q for %s of %q", v, k, path)
+			}
 		}
-		d.Set("rotation_period", n)
+		d.Set(k, n)
 	}
+	d.Set("rotation_schedule", role.Data["rotation_schedule"])
 
 	var rotation []string
 	if rotationStr, ok := role.Data["rotation_statements"].(string); ok {
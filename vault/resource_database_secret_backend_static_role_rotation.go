@@ -0,0 +1,96 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func databaseSecretBackendStaticRoleRotationResource() *schema.Resource {
+	return &schema.Resource{
+		Create: databaseSecretBackendStaticRoleRotationCreate,
+		Read:   databaseSecretBackendStaticRoleRotationRead,
+		Delete: databaseSecretBackendStaticRoleRotationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the Database secret backend the static role belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the static role to rotate.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary map of values that, when changed, will rotate the credentials again.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"last_vault_rotation": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time Vault last rotated the credentials, whether triggered by this resource or by the rotation period.",
+			},
+		},
+	}
+}
+
+func databaseSecretBackendStaticRoleRotationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := strings.Trim(d.Get("backend").(string), "/")
+	name := strings.Trim(d.Get("name").(string), "/")
+	path := backend + "/rotate-role/" + name
+
+	log.Printf("[DEBUG] Rotating credentials with %q", path)
+	if _, err := client.Logical().Write(path, nil); err != nil {
+		return fmt.Errorf("error rotating credentials with %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Rotated credentials with %q", path)
+
+	d.SetId(databaseSecretBackendStaticRolePath(backend, name))
+	return databaseSecretBackendStaticRoleRotationRead(d, meta)
+}
+
+func databaseSecretBackendStaticRoleRotationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading static role %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading static role %q: %s", path, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] Static role %q not found, removing rotation from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	// Scheduled rotations only move last_vault_rotation, which is computed,
+	// so they never cause another rotation through this resource.
+	d.Set("last_vault_rotation", resp.Data["last_vault_rotation"])
+
+	return nil
+}
+
+func databaseSecretBackendStaticRoleRotationDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[DEBUG] Removing rotation of static role %q from state; the credentials are left as they are", d.Id())
+	return nil
+}
@@ -0,0 +1,92 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccDatabaseSecretBackendStaticRoleRotation(t *testing.T) {
+	connURL := os.Getenv("MYSQL_URL")
+	if connURL == "" {
+		t.Skip("MYSQL_URL not set")
+	}
+	backend := acctest.RandomWithPrefix("tf-test-db")
+	name := acctest.RandomWithPrefix("staticrole")
+	username := acctest.RandomWithPrefix("user")
+	dbName := acctest.RandomWithPrefix("db")
+	resourceName := "vault_database_secret_backend_static_role_rotation.test"
+
+	if err := createTestUser(connURL, username); err != nil {
+		t.Fatal(err)
+	}
+
+	var lastRotation string
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccDatabaseSecretBackendStaticRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDatabaseSecretBackendStaticRoleRotationConfig(name, username, dbName, backend, connURL, "2021-01"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttrSet(resourceName, "last_vault_rotation"),
+					testAccDatabaseSecretBackendStaticRoleRotationCheck(resourceName, &lastRotation, false),
+				),
+			},
+			{
+				// Unchanged triggers must not rotate the credentials again.
+				Config: testAccDatabaseSecretBackendStaticRoleRotationConfig(name, username, dbName, backend, connURL, "2021-01"),
+				Check:  testAccDatabaseSecretBackendStaticRoleRotationCheck(resourceName, &lastRotation, false),
+			},
+			{
+				Config: testAccDatabaseSecretBackendStaticRoleRotationConfig(name, username, dbName, backend, connURL, "2021-02"),
+				Check:  testAccDatabaseSecretBackendStaticRoleRotationCheck(resourceName, &lastRotation, true),
+			},
+		},
+	})
+}
+
+// testAccDatabaseSecretBackendStaticRoleRotationCheck compares
+// last_vault_rotation against the value seen in the previous step, then
+// records the current one.
+func testAccDatabaseSecretBackendStaticRoleRotationCheck(resourceName string, last *string, rotated bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %q not found in state", resourceName)
+		}
+		current := rs.Primary.Attributes["last_vault_rotation"]
+
+		if *last != "" {
+			if rotated && current == *last {
+				return fmt.Errorf("expected credentials to be rotated, last_vault_rotation is still %q", current)
+			}
+			if !rotated && current != *last {
+				return fmt.Errorf("expected credentials not to be rotated, last_vault_rotation changed from %q to %q", *last, current)
+			}
+		}
+		*last = current
+
+		return nil
+	}
+}
+
+func testAccDatabaseSecretBackendStaticRoleRotationConfig(name, username, db, path, connURL, period string) string {
+	return testAccDatabaseSecretBackendStaticRoleConfig_basic(name, username, db, path, connURL) + fmt.Sprintf(`
+resource "vault_database_secret_backend_static_role_rotation" "test" {
+  backend = vault_mount.db.path
+  name    = vault_database_secret_backend_static_role.test.name
+
+  triggers = {
+    period = "%s"
+  }
+}
+`, period)
+}
@@ -5,6 +5,7 @@ import (
 	"database/sql"
 	"fmt"
 	"os"
+	"regexp"
 	"testing"
 
 	_ "github.com/go-sql-driver/mysql"
@@ -96,6 +97,59 @@ func TestAccDatabaseSecretBackendStaticRole_basic(t *testing.T) {
 	})
 }
 
+func TestAccDatabaseSecretBackendStaticRole_rotationSchedule(t *testing.T) {
+	connURL := os.Getenv("MYSQL_URL")
+	if connURL == "" {
+		t.Skip("MYSQL_URL not set")
+	}
+	backend := acctest.RandomWithPrefix("tf-test-db")
+	name := acctest.RandomWithPrefix("staticrole")
+	username := acctest.RandomWithPrefix("user")
+	dbName := acctest.RandomWithPrefix("db")
+	resourceName := "vault_database_secret_backend_static_role.test"
+
+	if err := createTestUser(connURL, username); err != nil {
+		t.Fatal(err)
+	}
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccDatabaseSecretBackendStaticRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccDatabaseSecretBackendStaticRoleConfig_rotation(name, username, dbName, backend, connURL, "rotation_period = 3600\n  rotation_schedule = \"0 0 * * SAT\""),
+				ExpectError: regexp.MustCompile("only one of `rotation_period,rotation_schedule` can be specified"),
+			},
+			{
+				Config:      testAccDatabaseSecretBackendStaticRoleConfig_rotation(name, username, dbName, backend, connURL, "rotation_schedule = \"0 0 * SAT\""),
+				ExpectError: regexp.MustCompile("expected rotation_schedule to be a cron schedule with 5 fields"),
+			},
+			{
+				Config: testAccDatabaseSecretBackendStaticRoleConfig_rotation(name, username, dbName, backend, connURL, "rotation_schedule = \"0 0 * * SAT\"\n  rotation_window = 7200"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rotation_schedule", "0 0 * * SAT"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_window", "7200"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_period", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccDatabaseSecretBackendStaticRoleConfig_rotation(name, username, dbName, backend, connURL, "rotation_period = 3600"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rotation_schedule", ""),
+					resource.TestCheckResourceAttr(resourceName, "rotation_window", "0"),
+					resource.TestCheckResourceAttr(resourceName, "rotation_period", "3600"),
+				),
+			},
+		},
+	})
+}
+
 func testAccDatabaseSecretBackendStaticRoleCheckDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -195,3 +249,30 @@ resource "vault_database_secret_backend_static_role" "test" {
 }
 `, path, db, connURL, name, username)
 }
+
+func testAccDatabaseSecretBackendStaticRoleConfig_rotation(name, username, db, path, connURL, rotation string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "db" {
+  path = "%s"
+  type = "database"
+}
+
+resource "vault_database_secret_backend_connection" "test" {
+  backend = "${vault_mount.db.path}"
+  name = "%s"
+  allowed_roles = ["*"]
+
+  mysql {
+	  connection_url = "%s"
+  }
+}
+
+resource "vault_database_secret_backend_static_role" "test" {
+  backend = "${vault_mount.db.path}"
+  db_name = "${vault_database_secret_backend_connection.test.name}"
+  name = "%s"
+  username = "%s"
+  %s
+}
+`, path, db, connURL, name, username, rotation)
+}
@@ -41,9 +41,10 @@ func egpPolicyResource() *schema.Resource {
 			},
 
 			"policy": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The policy document",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The policy document",
+				DiffSuppressFunc: sentinelPolicyDiffSuppress,
 			},
 		},
 	}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 
 	"github.com/hashicorp/vault/api"
 )
@@ -16,6 +17,9 @@ var (
 	gcpAuthRoleNameFromPathRegex = regexp.MustCompile("^auth/.+/role/([^/]+)$")
 )
 
+// gcpAuthBackendRoleGCEFields are only accepted by Vault for "gce" roles.
+var gcpAuthBackendRoleGCEFields = []string{"bound_zones", "bound_regions", "bound_instance_groups", "bound_labels"}
+
 func gcpAuthBackendRoleResource() *schema.Resource {
 	fields := map[string]*schema.Schema{
 		"role": {
@@ -24,9 +28,10 @@ func gcpAuthBackendRoleResource() *schema.Resource {
 			ForceNew: true,
 		},
 		"type": {
-			Type:     schema.TypeString,
-			Required: true,
-			ForceNew: true,
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice([]string{"iam", "gce"}, false),
 		},
 		"bound_projects": {
 			Type: schema.TypeSet,
@@ -92,7 +97,8 @@ func gcpAuthBackendRoleResource() *schema.Resource {
 		"bound_labels": {
 			Type: schema.TypeSet,
 			Elem: &schema.Schema{
-				Type: schema.TypeString,
+				Type:         schema.TypeString,
+				ValidateFunc: validateGCPAuthBackendRoleBoundLabel,
 			},
 			Optional: true,
 			Computed: true,
@@ -159,8 +165,47 @@ func gcpAuthBackendRoleResource() *schema.Resource {
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
-		Schema: fields,
+		Schema:        fields,
+		CustomizeDiff: gcpAuthBackendRoleCustomizeDiff,
+	}
+}
+
+// gcpAuthBackendRoleCustomizeDiff checks the fields required or rejected by
+// the role's type, which Vault would otherwise only reject when the role is
+// written.
+func gcpAuthBackendRoleCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Get("type").(string) != "iam" {
+		return nil
+	}
+	if d.NewValueKnown("bound_service_accounts") && d.Get("bound_service_accounts").(*schema.Set).Len() == 0 {
+		return fmt.Errorf("bound_service_accounts must be set for an %q role", "iam")
+	}
+	for _, k := range gcpAuthBackendRoleGCEFields {
+		if d.NewValueKnown(k) && d.Get(k).(*schema.Set).Len() > 0 {
+			return fmt.Errorf("%s is only valid for a %q role", k, "gce")
+		}
+	}
+	return nil
+}
+
+// validateGCPAuthBackendRoleBoundLabel checks a label is given as "key:value".
+// Vault trims whitespace around the key and value, so it isn't allowed here to
+// keep the labels read back identical.
+func validateGCPAuthBackendRoleBoundLabel(i interface{}, k string) ([]string, []error) {
+	v, ok := i.(string)
+	if !ok {
+		return nil, []error{fmt.Errorf("expected type of %s to be string", k)}
+	}
+	parts := strings.SplitN(v, ":", 2)
+	if len(parts) != 2 || parts[0] == "" {
+		return nil, []error{fmt.Errorf("expected %s to be of the form \"key:value\", got %q", k, v)}
+	}
+	for _, part := range parts {
+		if part != strings.TrimSpace(part) {
+			return nil, []error{fmt.Errorf("expected %s to have no whitespace around its key or value, got %q", k, v)}
+		}
 	}
+	return nil, nil
 }
 
 func gcpRoleResourcePath(backend, role string) string {
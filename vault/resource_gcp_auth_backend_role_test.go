@@ -3,6 +3,7 @@ package vault
 import (
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
@@ -132,6 +133,46 @@ func TestGCPAuthBackendRole_gce(t *testing.T) {
 	})
 }
 
+func TestGCPAuthBackendRole_iamInvalid(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-gcp-backend")
+	name := acctest.RandomWithPrefix("tf-test-gcp-role")
+	projectId := acctest.RandomWithPrefix("tf-test-gcp-project-id")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testGCPAuthBackendRoleConfig_iamInvalid(backend, name, projectId, ""),
+				ExpectError: regexp.MustCompile("bound_service_accounts must be set"),
+			},
+			{
+				Config:      testGCPAuthBackendRoleConfig_iamInvalid(backend, name, projectId, `bound_service_accounts = ["test"]`),
+				ExpectError: regexp.MustCompile("bound_zones is only valid"),
+			},
+		},
+	})
+}
+
+func TestValidateGCPAuthBackendRoleBoundLabel(t *testing.T) {
+	tests := map[string]bool{
+		"foo:bar":   true,
+		"foo:":      true,
+		"foo:a:b":   true,
+		"foo":       false,
+		":bar":      false,
+		"foo: bar":  false,
+		"foo :bar":  false,
+		" foo:bar ": false,
+	}
+	for label, valid := range tests {
+		_, errs := validateGCPAuthBackendRoleBoundLabel(label, "bound_labels")
+		if (len(errs) == 0) != valid {
+			t.Errorf("expected %q to be valid: %t, got errors: %v", label, valid, errs)
+		}
+	}
+}
+
 func TestGCPAuthBackendRole_deprecated(t *testing.T) {
 	backend := acctest.RandomWithPrefix("tf-test-gcp-backend")
 	name := acctest.RandomWithPrefix("tf-test-gcp-role")
@@ -434,3 +475,24 @@ resource "vault_gcp_auth_backend_role" "test" {
 `, backend, name, serviceAccount, projectId)
 
 }
+
+func testGCPAuthBackendRoleConfig_iamInvalid(backend, name, projectId, extra string) string {
+
+	return fmt.Sprintf(`
+
+resource "vault_auth_backend" "gcp" {
+    path = "%s"
+    type = "gcp"
+}
+
+resource "vault_gcp_auth_backend_role" "test" {
+    backend                = "${vault_auth_backend.gcp.path}"
+    role                   = "%s"
+    type                   = "iam"
+    bound_projects         = ["%s"]
+    bound_zones            = ["europe-west2-c"]
+    %s
+}
+`, backend, name, projectId, extra)
+
+}
@@ -6,6 +6,7 @@ import (
 	"log"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
 	"github.com/hashicorp/vault/api"
 )
 
@@ -25,6 +26,7 @@ func genericSecretResource() *schema.Resource {
 		MigrateState: resourceGenericSecretMigrateState,
 
 		Schema: map[string]*schema.Schema{
+			"namespace": namespaceSchema(),
 			"path": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -61,6 +63,21 @@ func genericSecretResource() *schema.Resource {
 				Description: "Don't attempt to read the token from Vault if true; drift won't be detected.",
 			},
 
+			"patch": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Only manage the keys in data_json, merging them into a KV v2 secret with a PATCH request and leaving any other keys intact. Requires Vault 1.9 or later.",
+			},
+
+			"delete_all_versions": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				Description:   "Only applicable for KV v2 secrets. If true, destroying the resource permanently deletes all versions and the metadata of the secret instead of soft-deleting the latest version.",
+				ConflictsWith: []string{"patch"},
+			},
+
 			"data": {
 				Type:        schema.TypeMap,
 				Computed:    true,
@@ -103,10 +120,13 @@ func NormalizeDataJSON(configI interface{}) string {
 }
 
 func genericSecretResourceWrite(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*api.Client)
+	client, err := namespaceClient(d, meta)
+	if err != nil {
+		return err
+	}
 
 	var data map[string]interface{}
-	err := json.Unmarshal([]byte(d.Get("data_json").(string)), &data)
+	err = json.Unmarshal([]byte(d.Get("data_json").(string)), &data)
 	if err != nil {
 		return fmt.Errorf("data_json %#v syntax error: %s", d.Get("data_json"), err)
 	}
@@ -118,6 +138,17 @@ func genericSecretResourceWrite(d *schema.ResourceData, meta interface{}) error
 		return fmt.Errorf("error determining if it's a v2 path: %s", err)
 	}
 
+	if d.Get("patch").(bool) {
+		if !v2 {
+			return fmt.Errorf("patch is only supported for KV v2 secrets, %q is not one", originalPath)
+		}
+		if err := genericSecretResourcePatch(d, client, addPrefixToVKVPath(path, mountPath, "data"), data); err != nil {
+			return err
+		}
+		d.SetId(originalPath)
+		return genericSecretResourceRead(d, meta)
+	}
+
 	if v2 {
 		path = addPrefixToVKVPath(path, mountPath, "data")
 		data = map[string]interface{}{
@@ -138,8 +169,63 @@ func genericSecretResourceWrite(d *schema.ResourceData, meta interface{}) error
 	return genericSecretResourceRead(d, meta)
 }
 
+// genericSecretResourcePatch merges the managed keys into the KV v2 secret at
+// path, removing any keys that are no longer managed. The secret is created
+// if it doesn't exist yet.
+func genericSecretResourcePatch(d *schema.ResourceData, client *api.Client, path string, data map[string]interface{}) error {
+	patch := map[string]interface{}{}
+	if !d.IsNewResource() {
+		o, _ := d.GetChange("data_json")
+		for k := range genericSecretManagedKeys(o.(string)) {
+			patch[k] = nil
+		}
+	}
+	for k, v := range data {
+		patch[k] = v
+	}
+
+	log.Printf("[DEBUG] Patching generic Vault secret at %s", path)
+	err := kvPatchRequest(client, path, map[string]interface{}{"data": patch})
+	if err == nil {
+		return nil
+	}
+	if !util.Is404(err) {
+		return fmt.Errorf("error patching %q in Vault: %s", path, err)
+	}
+
+	// Only create the secret if nothing else has created it in the meantime.
+	log.Printf("[DEBUG] Generic Vault secret at %s does not exist, creating it", path)
+	_, err = client.Logical().Write(path, map[string]interface{}{
+		"data": data,
+		"options": map[string]interface{}{
+			"cas": 0,
+		},
+	})
+	if err != nil {
+		return fmt.Errorf("error writing to Vault: %s", err)
+	}
+	return nil
+}
+
+// genericSecretManagedKeys returns the set of top-level keys in dataJSON,
+// which are the only ones a patch-mode secret manages.
+func genericSecretManagedKeys(dataJSON string) map[string]bool {
+	keys := map[string]bool{}
+	data := map[string]interface{}{}
+	if err := json.Unmarshal([]byte(dataJSON), &data); err != nil {
+		return keys
+	}
+	for k := range data {
+		keys[k] = true
+	}
+	return keys
+}
+
 func genericSecretResourceDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*api.Client)
+	client, err := namespaceClient(d, meta)
+	if err != nil {
+		return err
+	}
 
 	path := d.Id()
 
@@ -152,6 +238,28 @@ func genericSecretResourceDelete(d *schema.ResourceData, meta interface{}) error
 		path = addPrefixToVKVPath(path, mountPath, "data")
 	}
 
+	if v2 && d.Get("patch").(bool) {
+		patch := map[string]interface{}{}
+		for k := range genericSecretManagedKeys(d.Get("data_json").(string)) {
+			patch[k] = nil
+		}
+
+		log.Printf("[DEBUG] Removing managed keys from vault_generic_secret at %q", path)
+		err := kvPatchRequest(client, path, map[string]interface{}{"data": patch})
+		if err != nil && !util.Is404(err) {
+			return fmt.Errorf("error removing keys from %q in Vault: %s", path, err)
+		}
+		return nil
+	}
+
+	if v2 && d.Get("delete_all_versions").(bool) {
+		metadataPath := addPrefixToVKVPath(d.Id(), mountPath, "metadata")
+		if err := genericSecretCheckCapability(client, metadataPath, "delete"); err != nil {
+			return err
+		}
+		path = metadataPath
+	}
+
 	log.Printf("[DEBUG] Deleting vault_generic_secret from %q", path)
 	_, err = client.Logical().Delete(path)
 	if err != nil {
@@ -161,6 +269,21 @@ func genericSecretResourceDelete(d *schema.ResourceData, meta interface{}) error
 	return nil
 }
 
+// genericSecretCheckCapability returns an error if the token in use doesn't
+// have the given capability on path.
+func genericSecretCheckCapability(client *api.Client, path, capability string) error {
+	capabilities, err := client.Sys().CapabilitiesSelf(path)
+	if err != nil {
+		return fmt.Errorf("error checking capabilities on %q: %s", path, err)
+	}
+	for _, c := range capabilities {
+		if c == capability || c == "root" {
+			return nil
+		}
+	}
+	return fmt.Errorf("the %q capability on %q is required to delete all versions of the secret, got %v", capability, path, capabilities)
+}
+
 func genericSecretResourceRead(d *schema.ResourceData, meta interface{}) error {
 	var data map[string]interface{}
 	shouldRead := !d.Get("disable_read").(bool)
@@ -173,7 +296,10 @@ func genericSecretResourceRead(d *schema.ResourceData, meta interface{}) error {
 	path := d.Id()
 
 	if shouldRead {
-		client := meta.(*api.Client)
+		client, err := namespaceClient(d, meta)
+		if err != nil {
+			return err
+		}
 
 		log.Printf("[DEBUG] Reading %s from Vault", path)
 		secret, err := versionedSecret(latestSecretVersion, path, client)
@@ -190,7 +316,18 @@ func genericSecretResourceRead(d *schema.ResourceData, meta interface{}) error {
 		log.Printf("[DEBUG] secret: %#v", secret)
 
 		data = secret.Data
-		jsonData, err := json.Marshal(secret.Data)
+		if d.Get("patch").(bool) {
+			// Other keys in the secret are managed elsewhere, so only track
+			// the ones in our own configuration.
+			managed := genericSecretManagedKeys(d.Get("data_json").(string))
+			data = map[string]interface{}{}
+			for k, v := range secret.Data {
+				if managed[k] {
+					data[k] = v
+				}
+			}
+		}
+		jsonData, err := json.Marshal(data)
 		if err != nil {
 			return fmt.Errorf("error marshaling JSON for %q: %s", path, err)
 		}
@@ -206,6 +343,10 @@ func genericSecretResourceRead(d *schema.ResourceData, meta interface{}) error {
 		log.Printf("[WARN] vault_generic_secret does not refresh when disable_read is set to true")
 	}
 	d.Set("disable_read", !shouldRead)
+	// These only affect how the provider talks to Vault, so persist them as
+	// configured, including their defaults on import.
+	d.Set("patch", d.Get("patch").(bool))
+	d.Set("delete_all_versions", d.Get("delete_all_versions").(bool))
 
 	// Since our "data" map can only contain string values, we
 	// will take strings from Data and write them in as-is,
@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
@@ -155,3 +156,229 @@ func testResourceGenericSecret_updateCheck(s *terraform.State) error {
 
 	return nil
 }
+
+func TestResourceGenericSecret_patch(t *testing.T) {
+	mount := acctest.RandomWithPrefix("secretsv2")
+	path := mount + "/shared"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceGenericSecret_patchConfig(mount, "bar"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_generic_secret.a", "data.%", "1"),
+					resource.TestCheckResourceAttr("vault_generic_secret.a", "data.foo", "bar"),
+					resource.TestCheckResourceAttr("vault_generic_secret.b", "data.%", "1"),
+					resource.TestCheckResourceAttr("vault_generic_secret.b", "data.zip", "zap"),
+					testResourceGenericSecret_checkRemoteData(path, map[string]interface{}{"foo": "bar", "zip": "zap"}),
+				),
+			},
+			{
+				Config: testResourceGenericSecret_patchConfig(mount, "baz"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_generic_secret.a", "data.foo", "baz"),
+					resource.TestCheckResourceAttr("vault_generic_secret.b", "data.zip", "zap"),
+					testResourceGenericSecret_checkRemoteData(path, map[string]interface{}{"foo": "baz", "zip": "zap"}),
+				),
+			},
+			{
+				// Removing one resource must leave the other's keys in place.
+				Config: testResourceGenericSecret_patchConfigSingle(mount),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_generic_secret.b", "data.%", "1"),
+					testResourceGenericSecret_checkRemoteData(path, map[string]interface{}{"zip": "zap"}),
+				),
+			},
+		},
+	})
+}
+
+func TestResourceGenericSecret_deleteAllVersions(t *testing.T) {
+	mount := acctest.RandomWithPrefix("secretsv2")
+	path := mount + "/purged"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceGenericSecret_deleteAllVersionsConfig(mount, true),
+				Check:  resource.TestCheckResourceAttr("vault_generic_secret.test", "delete_all_versions", "true"),
+			},
+			{
+				// Dropping the secret must purge its metadata along with it.
+				Config: testResourceGenericSecret_deleteAllVersionsConfig(mount, false),
+				Check: func(s *terraform.State) error {
+					client := testProvider.Meta().(*api.Client)
+					resp, err := client.Logical().Read(mount + "/metadata/purged")
+					if err != nil {
+						return err
+					}
+					if resp != nil {
+						return fmt.Errorf("expected the metadata of %q to be deleted", path)
+					}
+					return nil
+				},
+			},
+		},
+	})
+}
+
+func TestResourceGenericSecret_disableRead(t *testing.T) {
+	mount := acctest.RandomWithPrefix("secretsv1")
+	path := mount + "/seeded"
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceGenericSecret_disableReadConfig(mount),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_generic_secret.test", "data_json", `{"password":"seed"}`),
+					testResourceGenericSecret_checkRemoteData(path, map[string]interface{}{"password": "seed"}),
+				),
+			},
+			{
+				// Changes made outside of Terraform, e.g. a rotation by Vault,
+				// must neither show up as drift nor be overwritten.
+				PreConfig: func() {
+					client := testProvider.Meta().(*api.Client)
+					if _, err := client.Logical().Write(path, map[string]interface{}{"password": "rotated"}); err != nil {
+						t.Fatalf("unable to rotate the secret via the SDK: %s", err)
+					}
+				},
+				Config: testResourceGenericSecret_disableReadConfig(mount),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_generic_secret.test", "data_json", `{"password":"seed"}`),
+					resource.TestCheckResourceAttr("vault_generic_secret.test", "data.password", "seed"),
+					testResourceGenericSecret_checkRemoteData(path, map[string]interface{}{"password": "rotated"}),
+				),
+			},
+			{
+				Config:   testResourceGenericSecret_disableReadConfig(mount),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testResourceGenericSecret_checkRemoteData(path string, expected map[string]interface{}) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testProvider.Meta().(*api.Client)
+		secret, err := versionedSecret(latestSecretVersion, path, client)
+		if err != nil {
+			return err
+		}
+		if secret == nil {
+			return fmt.Errorf("secret %q not found", path)
+		}
+		if !reflect.DeepEqual(secret.Data, expected) {
+			return fmt.Errorf("expected %q to contain %v, got %v", path, expected, secret.Data)
+		}
+		return nil
+	}
+}
+
+func testResourceGenericSecret_patchConfig(mount, foo string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "v2" {
+  path = "%s"
+  type = "kv"
+  options = {
+    version = "2"
+  }
+}
+
+resource "vault_generic_secret" "a" {
+  path  = "${vault_mount.v2.path}/shared"
+  patch = true
+  data_json = <<EOT
+{
+  "foo": "%s"
+}
+EOT
+}
+
+resource "vault_generic_secret" "b" {
+  path  = "${vault_mount.v2.path}/shared"
+  patch = true
+  data_json = <<EOT
+{
+  "zip": "zap"
+}
+EOT
+
+  depends_on = [vault_generic_secret.a]
+}
+`, mount, foo)
+}
+
+func testResourceGenericSecret_patchConfigSingle(mount string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "v2" {
+  path = "%s"
+  type = "kv"
+  options = {
+    version = "2"
+  }
+}
+
+resource "vault_generic_secret" "b" {
+  path  = "${vault_mount.v2.path}/shared"
+  patch = true
+  data_json = <<EOT
+{
+  "zip": "zap"
+}
+EOT
+}
+`, mount)
+}
+
+func testResourceGenericSecret_deleteAllVersionsConfig(mount string, withSecret bool) string {
+	config := fmt.Sprintf(`
+resource "vault_mount" "v2" {
+  path = "%s"
+  type = "kv"
+  options = {
+    version = "2"
+  }
+}
+`, mount)
+	if withSecret {
+		config += `
+resource "vault_generic_secret" "test" {
+  path                = "${vault_mount.v2.path}/purged"
+  delete_all_versions = true
+  data_json = <<EOT
+{
+  "foo": "bar"
+}
+EOT
+}
+`
+	}
+	return config
+}
+
+func testResourceGenericSecret_disableReadConfig(mount string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "v1" {
+  path = "%s"
+  type = "kv"
+  options = {
+    version = "1"
+  }
+}
+
+resource "vault_generic_secret" "test" {
+  path         = "${vault_mount.v1.path}/seeded"
+  disable_read = true
+  data_json = <<EOT
+{
+  "password": "seed"
+}
+EOT
+}
+`, mount)
+}
@@ -0,0 +1,93 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+const identityEntityMergePath = "/identity/entity/merge"
+
+func identityEntityMergeResource() *schema.Resource {
+	return &schema.Resource{
+		Create: identityEntityMergeCreate,
+		Read:   identityEntityMergeRead,
+		Delete: identityEntityMergeDelete,
+
+		Schema: map[string]*schema.Schema{
+			"from_entity_ids": {
+				Type:        schema.TypeSet,
+				Required:    true,
+				ForceNew:    true,
+				MinItems:    1,
+				Description: "IDs of the entities to merge into the entity of to_entity_id.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"to_entity_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "ID of the entity to merge the entities of from_entity_ids into.",
+			},
+
+			"conflicting_alias_ids_to_keep": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "IDs of the aliases to keep when the entities have aliases on the same mount.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+
+			"force": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Merge the entities even if they have conflicting MFA secrets.",
+			},
+		},
+	}
+}
+
+func identityEntityMergeCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	toEntityID := d.Get("to_entity_id").(string)
+
+	data := map[string]interface{}{
+		"from_entity_ids": util.TerraformSetToStringArray(d.Get("from_entity_ids")),
+		"to_entity_id":    toEntityID,
+		"force":           d.Get("force").(bool),
+	}
+	if v := util.TerraformSetToStringArray(d.Get("conflicting_alias_ids_to_keep")); len(v) > 0 {
+		data["conflicting_alias_ids_to_keep"] = v
+	}
+
+	log.Printf("[DEBUG] Merging IdentityEntities into %q", toEntityID)
+	if _, err := client.Logical().Write(identityEntityMergePath, data); err != nil {
+		return fmt.Errorf("error merging IdentityEntities into %q: %s", toEntityID, err)
+	}
+	log.Printf("[DEBUG] Merged IdentityEntities into %q", toEntityID)
+
+	d.SetId(toEntityID)
+
+	return identityEntityMergeRead(d, meta)
+}
+
+func identityEntityMergeRead(d *schema.ResourceData, meta interface{}) error {
+	// The merge can't be read back, the merged entities no longer exist.
+	return nil
+}
+
+func identityEntityMergeDelete(d *schema.ResourceData, meta interface{}) error {
+	// A merge can't be undone, so destroying it only removes it from state.
+	log.Printf("[DEBUG] Removing IdentityEntity merge into %q from state", d.Id())
+	return nil
+}
@@ -0,0 +1,80 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccIdentityEntityMerge(t *testing.T) {
+	entity := acctest.RandomWithPrefix("test-entity")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityEntityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityEntityMergeConfig(entity, false),
+			},
+			{
+				Config: testAccIdentityEntityMergeConfig(entity, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair("vault_identity_entity_merge.merge", "id", "vault_identity_entity.to", "id"),
+					resource.TestCheckResourceAttr("vault_identity_entity_merge.merge", "from_entity_ids.#", "1"),
+					testAccIdentityEntityMergeCheckMerged("vault_identity_entity.from"),
+				),
+				// The merged entity is gone, so vault_identity_entity.from
+				// wants to be recreated.
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccIdentityEntityMergeCheckMerged(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource %q not found in state", name)
+		}
+
+		client := testProvider.Meta().(*api.Client)
+
+		resp, err := client.Logical().Read(identityEntityIDPath(rs.Primary.ID))
+		if err != nil {
+			return fmt.Errorf("error reading IdentityEntity %q: %s", rs.Primary.ID, err)
+		}
+		if resp != nil {
+			return fmt.Errorf("IdentityEntity %q still exists after the merge", rs.Primary.ID)
+		}
+		return nil
+	}
+}
+
+func testAccIdentityEntityMergeConfig(entity string, merge bool) string {
+	config := fmt.Sprintf(`
+resource "vault_identity_entity" "from" {
+  name = "%s-from"
+}
+
+resource "vault_identity_entity" "to" {
+  name = "%s-to"
+}
+`, entity, entity)
+
+	if merge {
+		config += `
+resource "vault_identity_entity_merge" "merge" {
+  from_entity_ids = [vault_identity_entity.from.id]
+  to_entity_id    = vault_identity_entity.to.id
+}
+`
+	}
+
+	return config
+}
@@ -11,6 +11,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 	"github.com/hashicorp/vault/api"
+	"github.com/hashicorp/vault/sdk/helper/strutil"
 )
 
 func TestAccIdentityEntity(t *testing.T) {
@@ -107,6 +108,59 @@ func TestAccIdentityEntityUpdateRemovePolicies(t *testing.T) {
 	})
 }
 
+func TestAccIdentityEntityAliasContributedPolicies(t *testing.T) {
+	entity := acctest.RandomWithPrefix("test-entity")
+	backend := acctest.RandomWithPrefix("userpass")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityEntityDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityEntityConfigAliasPolicies(entity, backend),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_identity_entity.entity", "policies.#", "1"),
+					resource.TestCheckResourceAttr("vault_identity_entity.entity", "policies.1785148924", "test"),
+					testAccIdentityEntityCheckLoginPolicies(backend, "alice"),
+				),
+			},
+			{
+				// policies contributed by the auth method at login must not
+				// show up as drift on the entity
+				Config:   testAccIdentityEntityConfigAliasPolicies(entity, backend),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+// testAccIdentityEntityCheckLoginPolicies logs in through the alias and
+// checks the token carries both the policies of the auth method and those
+// of the entity, the latter as identity policies.
+func testAccIdentityEntityCheckLoginPolicies(backend, user string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, err := testProvider.Meta().(*api.Client).Clone()
+		if err != nil {
+			return err
+		}
+
+		secret, err := client.Logical().Write(fmt.Sprintf("auth/%s/login/%s", backend, user), map[string]interface{}{
+			"password": "password",
+		})
+		if err != nil {
+			return fmt.Errorf("error logging in: %s", err)
+		}
+		if !strutil.StrListContains(secret.Auth.TokenPolicies, "alias-policy") {
+			return fmt.Errorf("expected token policies %v to contain %q", secret.Auth.TokenPolicies, "alias-policy")
+		}
+		if !strutil.StrListContains(secret.Auth.IdentityPolicies, "test") {
+			return fmt.Errorf("expected identity policies %v to contain %q", secret.Auth.IdentityPolicies, "test")
+		}
+		return nil
+	}
+}
+
 func testAccCheckIdentityEntityDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -231,6 +285,35 @@ resource "vault_identity_entity" "entity" {
 }`, entityName)
 }
 
+func testAccIdentityEntityConfigAliasPolicies(entityName, backend string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "userpass" {
+  type = "userpass"
+  path = "%s"
+}
+
+resource "vault_generic_endpoint" "alice" {
+  path                 = "auth/${vault_auth_backend.userpass.path}/users/alice"
+  ignore_absent_fields = true
+
+  data_json = jsonencode({
+    password       = "password"
+    token_policies = ["alias-policy"]
+  })
+}
+
+resource "vault_identity_entity" "entity" {
+  name     = "%s"
+  policies = ["test"]
+}
+
+resource "vault_identity_entity_alias" "alice" {
+  name           = "alice"
+  mount_accessor = vault_auth_backend.userpass.accessor
+  canonical_id   = vault_identity_entity.entity.id
+}`, backend, entityName)
+}
+
 func testAccIdentityEntityConfigUpdate(entityName string) string {
 	return fmt.Sprintf(`
 resource "vault_identity_entity" "entity" {
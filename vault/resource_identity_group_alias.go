@@ -52,6 +52,10 @@ func identityGroupAliasCreate(d *schema.ResourceData, meta interface{}) error {
 
 	path := identityGroupAliasPath
 
+	if err := identityGroupAliasCheckExternal(client, canonicalID); err != nil {
+		return err
+	}
+
 	data := map[string]interface{}{
 		"name":           name,
 		"mount_accessor": mountAccessor,
@@ -97,6 +101,12 @@ func identityGroupAliasUpdate(d *schema.ResourceData, meta interface{}) error {
 		data["canonical_id"] = canonicalID
 	}
 
+	if d.HasChange("canonical_id") {
+		if err := identityGroupAliasCheckExternal(client, d.Get("canonical_id").(string)); err != nil {
+			return err
+		}
+	}
+
 	_, err = client.Logical().Write(path, data)
 
 	if err != nil {
@@ -104,6 +114,15 @@ func identityGroupAliasUpdate(d *schema.ResourceData, meta interface{}) error {
 	}
 	log.Printf("[DEBUG] Updated IdentityGroupAlias %q", id)
 
+	// An external group can only hold a single alias, so make sure that
+	// re-pointing the alias did not leave it attached to the previous group.
+	if d.HasChange("canonical_id") {
+		o, _ := d.GetChange("canonical_id")
+		if err := identityGroupAliasCheckDetached(client, id, o.(string)); err != nil {
+			return err
+		}
+	}
+
 	return identityGroupAliasRead(d, meta)
 }
 
@@ -143,7 +162,7 @@ func identityGroupAliasDelete(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Deleting IdentityGroupAlias %q", id)
 	_, err := client.Logical().Delete(path)
 	if err != nil {
-		return fmt.Errorf("error IdentityGroupAlias %q", id)
+		return fmt.Errorf("error deleting IdentityGroupAlias %q: %s", id, err)
 	}
 	log.Printf("[DEBUG] Deleted IdentityGroupAlias %q", id)
 
@@ -173,6 +192,45 @@ func identityGroupAliasExists(d *schema.ResourceData, meta interface{}) (bool, e
 	return resp != nil, nil
 }
 
+// identityGroupAliasCheckExternal checks the group an alias is for is an
+// external group, as Vault only allows aliases on those.
+func identityGroupAliasCheckExternal(client *api.Client, groupID string) error {
+	log.Printf("[DEBUG] Checking IdentityGroup %q is external", groupID)
+	resp, err := client.Logical().Read(identityGroupIDPath(groupID))
+	if err != nil {
+		return fmt.Errorf("error reading IdentityGroup %q: %s", groupID, err)
+	}
+	if resp == nil {
+		return fmt.Errorf("IdentityGroup %q not found", groupID)
+	}
+	if groupType, _ := resp.Data["type"].(string); groupType != "external" {
+		return fmt.Errorf("IdentityGroup %q is of type %q, group aliases can only be created for %q groups", groupID, groupType, "external")
+	}
+	return nil
+}
+
+func identityGroupAliasCheckDetached(client *api.Client, id, groupID string) error {
+	if groupID == "" {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Checking IdentityGroupAlias %q was detached from IdentityGroup %q", id, groupID)
+	resp, err := client.Logical().Read(identityGroupIDPath(groupID))
+	if err != nil {
+		return fmt.Errorf("error reading previous IdentityGroup %q of IdentityGroupAlias %q: %s", groupID, id, err)
+	}
+	if resp == nil {
+		return nil
+	}
+
+	if alias, ok := resp.Data["alias"].(map[string]interface{}); ok {
+		if aliasID, ok := alias["id"].(string); ok && aliasID == id {
+			return fmt.Errorf("IdentityGroupAlias %q is still attached to its previous IdentityGroup %q", id, groupID)
+		}
+	}
+	return nil
+}
+
 func identityGroupAliasNamePath(name string) string {
 	return fmt.Sprintf("%s/name/%s", identityGroupAliasPath, name)
 }
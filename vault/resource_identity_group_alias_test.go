@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
@@ -34,6 +35,22 @@ func TestAccIdentityGroupAlias(t *testing.T) {
 	})
 }
 
+func TestAccIdentityGroupAliasInternalGroup(t *testing.T) {
+	group := acctest.RandomWithPrefix("my-group")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityGroupAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccIdentityGroupAliasConfigInternalGroup(group),
+				ExpectError: regexp.MustCompile(`is of type "internal", group aliases can only be created for "external" groups`),
+			},
+		},
+	})
+}
+
 func TestAccIdentityGroupAliasUpdate(t *testing.T) {
 	suffix := acctest.RandomWithPrefix("")
 
@@ -70,6 +87,71 @@ func TestAccIdentityGroupAliasUpdate(t *testing.T) {
 	})
 }
 
+func TestAccIdentityGroupAliasUpdateCanonicalID(t *testing.T) {
+	suffix := acctest.RandomWithPrefix("")
+
+	nameGroupA := "vault_identity_group.groupA"
+	nameGroupB := "vault_identity_group.groupB"
+	nameGroupAlias := "vault_identity_group_alias.group-alias"
+	nameGithubA := "vault_auth_backend.githubA"
+	alias := acctest.RandomWithPrefix("alias-")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityGroupAliasDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityGroupAliasConfigUpdate(suffix, alias, nameGithubA, nameGroupA),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(nameGroupAlias, "canonical_id", nameGroupA, "id"),
+					testAccIdentityGroupAliasAttached(nameGroupA, nameGroupAlias, true),
+				),
+			},
+			{
+				Config: testAccIdentityGroupAliasConfigUpdate(suffix, alias, nameGithubA, nameGroupB),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrPair(nameGroupAlias, "canonical_id", nameGroupB, "id"),
+					testAccIdentityGroupAliasAttached(nameGroupA, nameGroupAlias, false),
+					testAccIdentityGroupAliasAttached(nameGroupB, nameGroupAlias, true),
+				),
+			},
+		},
+	})
+}
+
+func testAccIdentityGroupAliasAttached(groupResource, aliasResource string, attached bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		group, ok := s.RootModule().Resources[groupResource]
+		if !ok {
+			return fmt.Errorf("resource %q not found in state", groupResource)
+		}
+		alias, ok := s.RootModule().Resources[aliasResource]
+		if !ok {
+			return fmt.Errorf("resource %q not found in state", aliasResource)
+		}
+
+		client := testProvider.Meta().(*api.Client)
+		resp, err := client.Logical().Read(identityGroupIDPath(group.Primary.ID))
+		if err != nil {
+			return err
+		}
+		if resp == nil {
+			return fmt.Errorf("identity group %q not found", group.Primary.ID)
+		}
+
+		var aliasID string
+		if v, ok := resp.Data["alias"].(map[string]interface{}); ok {
+			aliasID, _ = v["id"].(string)
+		}
+		if got := aliasID == alias.Primary.ID; got != attached {
+			return fmt.Errorf("expected alias %q attached to group %q to be %t, got alias %q",
+				alias.Primary.ID, group.Primary.ID, attached, aliasID)
+		}
+		return nil
+	}
+}
+
 func testAccCheckIdentityGroupAliasDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -113,6 +195,25 @@ resource "vault_identity_group_alias" "group-alias" {
 }`, groupName, groupName, groupName, groupName)
 }
 
+func testAccIdentityGroupAliasConfigInternalGroup(groupName string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_group" "group" {
+  name = "%s"
+  type = "internal"
+}
+
+resource "vault_auth_backend" "github" {
+  type = "github"
+  path = "github-%s"
+}
+
+resource "vault_identity_group_alias" "group-alias" {
+  name = "%s"
+  mount_accessor = vault_auth_backend.github.accessor
+  canonical_id = vault_identity_group.group.id
+}`, groupName, groupName, groupName)
+}
+
 func testAccIdentityGroupAliasConfigUpdate(suffix, alias, backendName, groupName string) string {
 	return fmt.Sprintf(`
 resource "vault_identity_group" "groupA" {
@@ -0,0 +1,52 @@
+package vault
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func identityMFADuoResource() *schema.Resource {
+	return identityMFAMethodResource(&identityMFAMethod{
+		methodType: "duo",
+		writeOnly:  []string{"secret_key", "integration_key"},
+		// Vault accepts push_info, but returns it as pushinfo.
+		responseKeys: map[string]string{"push_info": "pushinfo"},
+		schema: map[string]*schema.Schema{
+			"secret_key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				Description:  "Secret key for Duo.",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"integration_key": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				Description:  "Integration key for Duo.",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"api_hostname": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "API hostname for Duo.",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"username_format": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A template for mapping identities to Duo usernames. Values to substitute should be placed in `{{}}`.",
+			},
+			"push_info": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Additional information displayed in the Duo push notification, as a URL-encoded key/value list.",
+			},
+			"use_passcode": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Require the user to provide a passcode instead of approving a push notification.",
+			},
+		},
+	})
+}
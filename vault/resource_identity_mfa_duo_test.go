@@ -0,0 +1,61 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestIdentityMFADuo(t *testing.T) {
+	resourceName := "vault_identity_mfa_duo.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testIdentityMFAMethodCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testIdentityMFADuoConfig("8C7THtrIigh2rPZQMbguugt8IUftWhMRCOBzbuyz", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "method_id"),
+					resource.TestCheckResourceAttr(resourceName, "api_hostname", "api-2b5c39f5.duosecurity.com"),
+					resource.TestCheckResourceAttr(resourceName, "username_format", "{{identity.entity.name}}"),
+					resource.TestCheckResourceAttr(resourceName, "push_info", "from=loginortal&domain=example.com"),
+					resource.TestCheckResourceAttr(resourceName, "use_passcode", "false"),
+				),
+			},
+			{
+				// Vault doesn't return the keys, which must not diff.
+				Config:   testIdentityMFADuoConfig("8C7THtrIigh2rPZQMbguugt8IUftWhMRCOBzbuyz", false),
+				PlanOnly: true,
+			},
+			{
+				Config: testIdentityMFADuoConfig("9D8UIusJjhi3sQAQNchvvhu9JVguXiNSDPCacvza", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "secret_key", "9D8UIusJjhi3sQAQNchvvhu9JVguXiNSDPCacvza"),
+					resource.TestCheckResourceAttr(resourceName, "use_passcode", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret_key", "integration_key"},
+			},
+		},
+	})
+}
+
+func testIdentityMFADuoConfig(secretKey string, usePasscode bool) string {
+	return fmt.Sprintf(`
+resource "vault_identity_mfa_duo" "test" {
+  secret_key      = "%s"
+  integration_key = "BIACEUEAXI20BNWTEYXT"
+  api_hostname    = "api-2b5c39f5.duosecurity.com"
+  username_format = "{{identity.entity.name}}"
+  push_info       = "from=loginortal&domain=example.com"
+  use_passcode    = %t
+}
+`, secretKey, usePasscode)
+}
@@ -0,0 +1,185 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+// identityMFAMethod describes one type of login MFA method. All of them share
+// the same endpoints under identity/mfa/method/<type> and only differ in their
+// parameters.
+type identityMFAMethod struct {
+	// methodType is the type as it appears in the path, e.g. "totp".
+	methodType string
+	// schema holds the parameters specific to the method type.
+	schema map[string]*schema.Schema
+	// writeOnly lists the parameters Vault never returns. They're kept as
+	// they are in state.
+	writeOnly []string
+	// responseKeys maps parameters to the keys Vault returns them under,
+	// where the two differ.
+	responseKeys map[string]string
+}
+
+func identityMFAMethodResource(m *identityMFAMethod) *schema.Resource {
+	s := map[string]*schema.Schema{
+		"method_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The unique ID generated by Vault for the method.",
+		},
+		"namespace_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The ID of the namespace the method belongs to.",
+		},
+	}
+	for k, v := range m.schema {
+		s[k] = v
+	}
+
+	return &schema.Resource{
+		Create: func(d *schema.ResourceData, meta interface{}) error {
+			return identityMFAMethodCreate(m, d, meta)
+		},
+		Read: func(d *schema.ResourceData, meta interface{}) error {
+			return identityMFAMethodRead(m, d, meta)
+		},
+		Update: func(d *schema.ResourceData, meta interface{}) error {
+			return identityMFAMethodUpdate(m, d, meta)
+		},
+		Delete: func(d *schema.ResourceData, meta interface{}) error {
+			return identityMFAMethodDelete(m, d, meta)
+		},
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: s,
+	}
+}
+
+func identityMFAMethodCreate(m *identityMFAMethod, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := identityMFAMethodPath(m.methodType)
+
+	log.Printf("[DEBUG] Creating %s MFA method", m.methodType)
+	resp, err := client.Logical().Write(path, identityMFAMethodRequestData(m, d))
+	if err != nil {
+		return fmt.Errorf("error creating %s MFA method: %s", m.methodType, err)
+	}
+	if resp == nil {
+		return fmt.Errorf("no method_id returned when creating %s MFA method", m.methodType)
+	}
+	methodID, ok := resp.Data["method_id"].(string)
+	if !ok || methodID == "" {
+		return fmt.Errorf("no method_id returned when creating %s MFA method", m.methodType)
+	}
+	log.Printf("[DEBUG] Created %s MFA method %q", m.methodType, methodID)
+
+	d.SetId(methodID)
+
+	return identityMFAMethodRead(m, d, meta)
+}
+
+func identityMFAMethodRead(m *identityMFAMethod, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := identityMFAMethodPath(m.methodType, d.Id())
+
+	log.Printf("[DEBUG] Reading MFA method %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading MFA method %q: %s", path, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] MFA method %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+	log.Printf("[DEBUG] Read MFA method %q", path)
+
+	d.Set("method_id", d.Id())
+	d.Set("namespace_id", resp.Data["namespace_id"])
+
+	writeOnly := map[string]bool{}
+	for _, k := range m.writeOnly {
+		writeOnly[k] = true
+	}
+	for k, s := range m.schema {
+		responseKey := k
+		if v, ok := m.responseKeys[k]; ok {
+			responseKey = v
+		}
+		v, ok := resp.Data[responseKey]
+		if writeOnly[k] || !ok {
+			continue
+		}
+		if n, isNumber := v.(json.Number); isNumber && s.Type == schema.TypeInt {
+			i, err := n.Int64()
+			if err != nil {
+				return fmt.Errorf("expected %s %q to be a number, and it isn't", k, v)
+			}
+			v = i
+		}
+		if err := d.Set(k, v); err != nil {
+			return fmt.Errorf("error setting %s for MFA method %q: %s", k, path, err)
+		}
+	}
+
+	return nil
+}
+
+func identityMFAMethodUpdate(m *identityMFAMethod, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := identityMFAMethodPath(m.methodType, d.Id())
+
+	log.Printf("[DEBUG] Updating MFA method %q", path)
+	if _, err := client.Logical().Write(path, identityMFAMethodRequestData(m, d)); err != nil {
+		return fmt.Errorf("error updating MFA method %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Updated MFA method %q", path)
+
+	return identityMFAMethodRead(m, d, meta)
+}
+
+func identityMFAMethodDelete(m *identityMFAMethod, d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := identityMFAMethodPath(m.methodType, d.Id())
+
+	log.Printf("[DEBUG] Deleting MFA method %q", path)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return fmt.Errorf("error deleting MFA method %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted MFA method %q", path)
+
+	return nil
+}
+
+func identityMFAMethodRequestData(m *identityMFAMethod, d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{}
+	for k, s := range m.schema {
+		if s.Computed && !s.Optional {
+			continue
+		}
+		data[k] = d.Get(k)
+	}
+
+	return data
+}
+
+func identityMFAMethodPath(methodType string, methodID ...string) string {
+	path := "identity/mfa/method/" + methodType
+	if len(methodID) > 0 {
+		path += "/" + methodID[0]
+	}
+
+	return path
+}
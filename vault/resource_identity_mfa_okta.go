@@ -0,0 +1,43 @@
+package vault
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func identityMFAOktaResource() *schema.Resource {
+	return identityMFAMethodResource(&identityMFAMethod{
+		methodType: "okta",
+		writeOnly:  []string{"api_token"},
+		schema: map[string]*schema.Schema{
+			"org_name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "Name of the organization to be used in the Okta API.",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"api_token": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				Description:  "Okta API token.",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"base_url": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The base domain to use for the Okta API. If not set, okta.com is used.",
+			},
+			"username_format": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A template for mapping identities to Okta usernames. Values to substitute should be placed in `{{}}`.",
+			},
+			"primary_email": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Match the user by their primary email address in Okta, instead of their login.",
+			},
+		},
+	})
+}
@@ -0,0 +1,55 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestIdentityMFAOkta(t *testing.T) {
+	orgName := acctest.RandomWithPrefix("org")
+	resourceName := "vault_identity_mfa_okta.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testIdentityMFAMethodCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testIdentityMFAOktaConfig(orgName, "okta.com", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "method_id"),
+					resource.TestCheckResourceAttr(resourceName, "org_name", orgName),
+					resource.TestCheckResourceAttr(resourceName, "base_url", "okta.com"),
+					resource.TestCheckResourceAttr(resourceName, "primary_email", "false"),
+				),
+			},
+			{
+				Config: testIdentityMFAOktaConfig(orgName, "oktapreview.com", true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "base_url", "oktapreview.com"),
+					resource.TestCheckResourceAttr(resourceName, "primary_email", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"api_token"},
+			},
+		},
+	})
+}
+
+func testIdentityMFAOktaConfig(orgName, baseURL string, primaryEmail bool) string {
+	return fmt.Sprintf(`
+resource "vault_identity_mfa_okta" "test" {
+  org_name      = "%s"
+  api_token     = "token1"
+  base_url      = "%s"
+  primary_email = %t
+}
+`, orgName, baseURL, primaryEmail)
+}
@@ -0,0 +1,52 @@
+package vault
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func identityMFAPingIDResource() *schema.Resource {
+	return identityMFAMethodResource(&identityMFAMethod{
+		methodType: "pingid",
+		writeOnly:  []string{"settings_file_base64"},
+		schema: map[string]*schema.Schema{
+			"settings_file_base64": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Sensitive:    true,
+				Description:  "The base64 encoded contents of the PingID settings file downloaded from the PingID admin console.",
+				ValidateFunc: validation.StringIsBase64,
+			},
+			"username_format": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "A template for mapping identities to PingID usernames. Values to substitute should be placed in `{{}}`.",
+			},
+			"use_signature": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "Whether requests to PingID are signed, as set in the settings file.",
+			},
+			"idp_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The IDP URL from the settings file.",
+			},
+			"admin_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The admin URL from the settings file.",
+			},
+			"authenticator_url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The authenticator URL from the settings file.",
+			},
+			"org_alias": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The organization alias from the settings file.",
+			},
+		},
+	})
+}
@@ -0,0 +1,50 @@
+package vault
+
+import (
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestIdentityMFAPingID(t *testing.T) {
+	resourceName := "vault_identity_mfa_pingid.test"
+	settings := base64.StdEncoding.EncodeToString([]byte(`use_base64_key=YWJj
+use_signature=true
+token=token1
+idp_url=https://idpxnyl3m.pingidentity.com/pingid
+org_alias=org-alias
+admin_url=https://idpxnyl3m.pingidentity.com/pingid
+authenticator_url=https://authenticator.pingone.com/pingid/ppm
+`))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testIdentityMFAMethodCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "vault_identity_mfa_pingid" "test" {
+  settings_file_base64 = "%s"
+  username_format      = "{{identity.entity.name}}"
+}
+`, settings),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "method_id"),
+					resource.TestCheckResourceAttr(resourceName, "username_format", "{{identity.entity.name}}"),
+					resource.TestCheckResourceAttr(resourceName, "use_signature", "true"),
+					resource.TestCheckResourceAttr(resourceName, "org_alias", "org-alias"),
+					resource.TestCheckResourceAttr(resourceName, "idp_url", "https://idpxnyl3m.pingidentity.com/pingid"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"settings_file_base64"},
+			},
+		},
+	})
+}
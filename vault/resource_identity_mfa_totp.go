@@ -0,0 +1,69 @@
+package vault
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+)
+
+func identityMFATOTPResource() *schema.Resource {
+	return identityMFAMethodResource(&identityMFAMethod{
+		methodType: "totp",
+		schema: map[string]*schema.Schema{
+			"issuer": {
+				Type:         schema.TypeString,
+				Required:     true,
+				Description:  "The name of the key's issuing organization.",
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+			"period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      30,
+				Description:  "The length of time in seconds used to generate a counter for the TOTP token calculation.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"key_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      20,
+				Description:  "The size in bytes of the generated key.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"qr_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      200,
+				Description:  "The pixel size of the generated square QR code. Set to 0 to not generate a QR code.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "SHA1",
+				Description:  "The hashing algorithm used to generate the TOTP code. One of \"SHA1\", \"SHA256\" or \"SHA512\".",
+				ValidateFunc: validation.StringInSlice([]string{"SHA1", "SHA256", "SHA512"}, false),
+			},
+			"digits": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      6,
+				Description:  "The number of digits in the generated TOTP code. One of 6 or 8.",
+				ValidateFunc: validation.IntInSlice([]int{6, 8}),
+			},
+			"skew": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				Description:  "The number of delay periods allowed when validating a TOTP code. One of 0 or 1.",
+				ValidateFunc: validation.IntInSlice([]int{0, 1}),
+			},
+			"max_validation_attempts": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      5,
+				Description:  "The maximum number of consecutive failed validation attempts before the user is locked out.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+		},
+	})
+}
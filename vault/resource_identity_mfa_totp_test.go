@@ -0,0 +1,85 @@
+package vault
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestIdentityMFATOTP(t *testing.T) {
+	issuer := acctest.RandomWithPrefix("issuer")
+	resourceName := "vault_identity_mfa_totp.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testIdentityMFAMethodCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "vault_identity_mfa_totp" "test" {
+  issuer = "%s"
+}
+`, issuer),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "method_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "method_id", resourceName, "id"),
+					resource.TestCheckResourceAttr(resourceName, "issuer", issuer),
+					resource.TestCheckResourceAttr(resourceName, "period", "30"),
+					resource.TestCheckResourceAttr(resourceName, "algorithm", "SHA1"),
+					resource.TestCheckResourceAttr(resourceName, "digits", "6"),
+					resource.TestCheckResourceAttr(resourceName, "skew", "1"),
+				),
+			},
+			{
+				Config: fmt.Sprintf(`
+resource "vault_identity_mfa_totp" "test" {
+  issuer                  = "%s"
+  period                  = 60
+  algorithm               = "SHA256"
+  digits                  = 8
+  skew                    = 0
+  max_validation_attempts = 3
+}
+`, issuer),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "period", "60"),
+					resource.TestCheckResourceAttr(resourceName, "algorithm", "SHA256"),
+					resource.TestCheckResourceAttr(resourceName, "digits", "8"),
+					resource.TestCheckResourceAttr(resourceName, "skew", "0"),
+					resource.TestCheckResourceAttr(resourceName, "max_validation_attempts", "3"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testIdentityMFAMethodCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if !strings.HasPrefix(rs.Type, "vault_identity_mfa_") {
+			continue
+		}
+		methodType := strings.TrimPrefix(rs.Type, "vault_identity_mfa_")
+		resp, err := client.Logical().Read(identityMFAMethodPath(methodType, rs.Primary.ID))
+		if err != nil {
+			return err
+		}
+		if resp != nil {
+			return fmt.Errorf("MFA method %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
@@ -6,6 +6,8 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-provider-vault/util"
 	"github.com/hashicorp/vault/api"
 )
 
@@ -18,6 +20,17 @@ var (
 		"algorithm",
 		"allowed_client_ids",
 	}
+
+	// identityOidcKeyAlgorithms are the signing algorithms supported by Vault.
+	identityOidcKeyAlgorithms = []string{
+		"RS256",
+		"RS384",
+		"RS512",
+		"ES256",
+		"ES384",
+		"ES512",
+		"EdDSA",
+	}
 )
 
 func identityOidcKey() *schema.Resource {
@@ -40,24 +53,29 @@ func identityOidcKey() *schema.Resource {
 			},
 
 			"rotation_period": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Description: "How often to generate a new signing key in number of seconds",
-				Default:     86400,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "How often to generate a new signing key, in seconds or as a duration string like \"24h\".",
+				Default:          "86400",
+				ValidateFunc:     validateDurationSeconds,
+				DiffSuppressFunc: util.DurationSecondsDiffSuppress,
 			},
 
 			"verification_ttl": {
-				Type:        schema.TypeInt,
-				Optional:    true,
-				Description: "Controls how long the public portion of a signing key will be available for verification after being rotated in seconds.",
-				Default:     86400,
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Controls how long the public portion of a signing key will be available for verification after being rotated, in seconds or as a duration string like \"24h\".",
+				Default:          "86400",
+				ValidateFunc:     validateDurationSeconds,
+				DiffSuppressFunc: util.DurationSecondsDiffSuppress,
 			},
 
 			"algorithm": {
-				Type:        schema.TypeString,
-				Description: "Signing algorithm to use. Signing algorithm to use. Allowed values are: RS256 (default), RS384, RS512, ES256, ES384, ES512, EdDSA.",
-				Optional:    true,
-				Default:     "RS256",
+				Type:         schema.TypeString,
+				Description:  "Signing algorithm to use. Allowed values are: RS256 (default), RS384, RS512, ES256, ES384, ES512, EdDSA.",
+				Optional:     true,
+				Default:      "RS256",
+				ValidateFunc: validation.StringInSlice(identityOidcKeyAlgorithms, false),
 			},
 
 			"allowed_client_ids": {
@@ -72,8 +90,8 @@ func identityOidcKey() *schema.Resource {
 }
 
 func identityOidcKeyUpdateFields(d *schema.ResourceData, data map[string]interface{}) {
-	data["rotation_period"] = d.Get("rotation_period").(int)
-	data["verification_ttl"] = d.Get("verification_ttl").(int)
+	data["rotation_period"] = d.Get("rotation_period").(string)
+	data["verification_ttl"] = d.Get("verification_ttl").(string)
 	data["algorithm"] = d.Get("algorithm").(string)
 
 	if d.IsNewResource() || d.HasChange("allowed_client_ids") {
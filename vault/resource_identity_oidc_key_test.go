@@ -3,6 +3,7 @@ package vault
 import (
 	"encoding/json"
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
@@ -79,6 +80,50 @@ func TestAccIdentityOidcKeyUpdate(t *testing.T) {
 	})
 }
 
+func TestAccIdentityOidcKey_durations(t *testing.T) {
+	key := acctest.RandomWithPrefix("test-key")
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccCheckIdentityOidcKeyDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityOidcKeyConfigDurations(key),
+				Check: resource.ComposeTestCheckFunc(
+					testAccIdentityOidcKeyCheckAttrs(),
+					resource.TestCheckResourceAttr("vault_identity_oidc_key.key", "rotation_period", "86400"),
+					resource.TestCheckResourceAttr("vault_identity_oidc_key.key", "verification_ttl", "172800"),
+				),
+			},
+			{
+				// Vault reports the durations in seconds, which must not diff.
+				Config:   testAccIdentityOidcKeyConfigDurations(key),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccIdentityOidcKey_invalidAlgorithm(t *testing.T) {
+	key := acctest.RandomWithPrefix("test-key")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: fmt.Sprintf(`
+resource "vault_identity_oidc_key" "key" {
+  name      = "%s"
+  algorithm = "HS256"
+}`, key),
+				ExpectError: regexp.MustCompile(`expected algorithm to be one of \[RS256 RS384 RS512 ES256 ES384 ES512 EdDSA\]`),
+			},
+		},
+	})
+}
+
 func testAccCheckIdentityOidcKeyDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -215,3 +260,12 @@ resource "vault_identity_oidc_key" "key" {
 	allowed_client_ids = ["*"]
 }`, entityName)
 }
+
+func testAccIdentityOidcKeyConfigDurations(entityName string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_oidc_key" "key" {
+  name             = "%s"
+  rotation_period  = "24h"
+  verification_ttl = "48h"
+}`, entityName)
+}
@@ -1,8 +1,11 @@
 package vault
 
 import (
+	"encoding/base64"
+	"encoding/json"
 	"fmt"
 	"log"
+	"reflect"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/vault/api"
@@ -33,13 +36,13 @@ func identityOidcRole() *schema.Resource {
 				Type:        schema.TypeString,
 				Description: "A configured named key, the key must already exist.",
 				Required:    true,
-				ForceNew:    true,
 			},
 
 			"template": {
-				Type:        schema.TypeString,
-				Description: "The template string to use for generating tokens. This may be in string-ified JSON or base64 format.",
-				Optional:    true,
+				Type:             schema.TypeString,
+				Description:      "The template string to use for generating tokens. This may be in string-ified JSON or base64 format.",
+				Optional:         true,
+				DiffSuppressFunc: identityOidcRoleTemplateDiffSuppress,
 			},
 
 			"ttl": {
@@ -59,6 +62,32 @@ func identityOidcRole() *schema.Resource {
 	}
 }
 
+// identityOidcRoleTemplateDiffSuppress ignores differences between templates
+// that Vault considers the same. Vault decodes base64 templates before storing
+// them, and JSON templates are compared structurally.
+func identityOidcRoleTemplateDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	old, new = identityOidcRoleDecodeTemplate(old), identityOidcRoleDecodeTemplate(new)
+	if old == new {
+		return true
+	}
+
+	var oldJSON, newJSON interface{}
+	if err := json.Unmarshal([]byte(old), &oldJSON); err != nil {
+		return false
+	}
+	if err := json.Unmarshal([]byte(new), &newJSON); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(oldJSON, newJSON)
+}
+
+func identityOidcRoleDecodeTemplate(template string) string {
+	if decoded, err := base64.StdEncoding.DecodeString(template); err == nil {
+		return string(decoded)
+	}
+	return template
+}
+
 func identityOidcRoleUpdateFields(d *schema.ResourceData, data map[string]interface{}) {
 	data["key"] = d.Get("key").(string)
 	data["client_id"] = d.Get("client_id").(string)
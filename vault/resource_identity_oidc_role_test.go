@@ -113,6 +113,64 @@ func TestAccIdentityOidcRoleUpdate(t *testing.T) {
 	})
 }
 
+func TestAccIdentityOidcRole_keyUpdate(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-role")
+	var clientID string
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testAccCheckIdentityOidcRoleDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccIdentityOidcRoleKeyUpdateConfig(name, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccIdentityOidcRoleCheckAttrs(),
+					resource.TestCheckResourceAttr("vault_identity_oidc_role.role", "key", name+"-first"),
+					func(s *terraform.State) error {
+						clientID = s.RootModule().Resources["vault_identity_oidc_role.role"].Primary.Attributes["client_id"]
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccIdentityOidcRoleKeyUpdateConfig(name, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccIdentityOidcRoleCheckAttrs(),
+					resource.TestCheckResourceAttr("vault_identity_oidc_role.role", "key", name+"-second"),
+					func(s *terraform.State) error {
+						// The role is updated in place, so it keeps its generated client ID.
+						actual := s.RootModule().Resources["vault_identity_oidc_role.role"].Primary.Attributes["client_id"]
+						if actual != clientID {
+							return fmt.Errorf("expected client_id %q to be unchanged, got %q", clientID, actual)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestIdentityOidcRoleTemplateDiffSuppress(t *testing.T) {
+	tests := []struct {
+		old, new string
+		suppress bool
+	}{
+		{`{"team": "platform"}`, `{"team": "platform"}`, true},
+		{`{"team": "platform"}`, `{ "team":"platform" }`, true},
+		{`{"team": "platform"}`, "eyJ0ZWFtIjogInBsYXRmb3JtIn0=", true},
+		{`{"team": "platform"}`, `{"team": "security"}`, false},
+		{testAccIdentityOidcRoleTemplate, testAccIdentityOidcRoleTemplate, true},
+		{testAccIdentityOidcRoleTemplate, `{"name": {{identity.entity.id}}}`, false},
+	}
+	for _, tt := range tests {
+		if actual := identityOidcRoleTemplateDiffSuppress("template", tt.old, tt.new, nil); actual != tt.suppress {
+			t.Errorf("expected suppress to be %t for %q and %q, got %t", tt.suppress, tt.old, tt.new, actual)
+		}
+	}
+}
+
 func testAccCheckIdentityOidcRoleDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -275,3 +333,23 @@ EOF
 	ttl = 3600
 }`, entityName, entityName, clientId, testAccIdentityOidcRoleTemplate)
 }
+
+func testAccIdentityOidcRoleKeyUpdateConfig(entityName, keySuffix string) string {
+	return fmt.Sprintf(`
+resource "vault_identity_oidc_key" "first" {
+  name = "%[1]s-first"
+  allowed_client_ids = ["*"]
+}
+
+resource "vault_identity_oidc_key" "second" {
+  name = "%[1]s-second"
+  allowed_client_ids = ["*"]
+}
+
+resource "vault_identity_oidc_role" "role" {
+  name = "%[1]s"
+  key = vault_identity_oidc_key.%[2]s.name
+  template = base64encode("{\"team\": \"platform\"}")
+}
+`, entityName, keySuffix)
+}
@@ -7,6 +7,7 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
 	"github.com/hashicorp/terraform-provider-vault/util"
 	"github.com/hashicorp/vault/api"
 )
@@ -25,11 +26,12 @@ func jwtAuthBackendRoleResource() *schema.Resource {
 			ForceNew:    true,
 		},
 		"role_type": {
-			Type:        schema.TypeString,
-			Description: "Type of role, either \"oidc\" (default) or \"jwt\"",
-			Optional:    true,
-			Computed:    true,
-			ForceNew:    true,
+			Type:         schema.TypeString,
+			Description:  "Type of role, either \"oidc\" (default) or \"jwt\"",
+			Optional:     true,
+			Computed:     true,
+			ForceNew:     true,
+			ValidateFunc: validation.StringInSlice([]string{"oidc", "jwt"}, false),
 		},
 		"bound_audiences": {
 			Type:        schema.TypeSet,
@@ -90,9 +92,10 @@ func jwtAuthBackendRoleResource() *schema.Resource {
 			Description: "How to interpret values in the claims/values map: can be either \"string\" (exact match) or \"glob\" (wildcard match).",
 		},
 		"bound_claims": {
-			Type:        schema.TypeMap,
-			Optional:    true,
-			Description: "Map of claims/values to match against. The expected value may be a single string or a comma-separated string list.",
+			Type:             schema.TypeMap,
+			Optional:         true,
+			Description:      "Map of claims/values to match against. The expected value may be a single string or a comma-separated string list.",
+			DiffSuppressFunc: jwtAuthBackendRoleBoundClaimDiffSuppress,
 		},
 		"claim_mappings": {
 			Type:        schema.TypeMap,
@@ -390,10 +393,14 @@ func jwtAuthBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
 			}
 		}
 		d.Set("bound_claims", boundClaims)
+	} else {
+		d.Set("bound_claims", nil)
 	}
 
 	if resp.Data["claim_mappings"] != nil {
 		d.Set("claim_mappings", resp.Data["claim_mappings"])
+	} else {
+		d.Set("claim_mappings", nil)
 	}
 
 	d.Set("groups_claim", resp.Data["groups_claim"].(string))
@@ -483,6 +490,26 @@ func jwtAuthBackendRoleExists(d *schema.ResourceData, meta interface{}) (bool, e
 	return resp != nil, nil
 }
 
+// jwtAuthBackendRoleBoundClaimDiffSuppress ignores whitespace around the
+// values of a comma-separated bound claim, which Vault stores as a list and
+// returns joined without it.
+func jwtAuthBackendRoleBoundClaimDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	if k == "bound_claims.%" {
+		return false
+	}
+	oldVals := strings.Split(old, ",")
+	newVals := strings.Split(new, ",")
+	if len(oldVals) != len(newVals) {
+		return false
+	}
+	for i := range oldVals {
+		if strings.TrimSpace(oldVals[i]) != strings.TrimSpace(newVals[i]) {
+			return false
+		}
+	}
+	return true
+}
+
 func jwtAuthBackendRolePath(backend, role string) string {
 	return "auth/" + strings.Trim(backend, "/") + "/role/" + strings.Trim(role, "/")
 }
@@ -552,10 +579,14 @@ func jwtAuthBackendRoleDataToWrite(d *schema.ResourceData, create bool) map[stri
 			}
 		}
 		data["bound_claims"] = boundClaims
+	} else if !create && d.HasChange("bound_claims") {
+		data["bound_claims"] = map[string]interface{}{}
 	}
 
 	if v, ok := d.GetOk("claim_mappings"); ok {
 		data["claim_mappings"] = v
+	} else if !create && d.HasChange("claim_mappings") {
+		data["claim_mappings"] = map[string]interface{}{}
 	}
 
 	if v, ok := d.GetOkExists("groups_claim"); ok {
@@ -648,7 +648,7 @@ resource "vault_jwt_auth_backend_role" "role" {
   token_max_ttl = 7200
   bound_claims_type = "string"
   bound_claims = {
-    department = "engineering,admin"
+    department = "engineering, admin"
     sector = "7g"
   }
   claim_mappings = {
@@ -736,3 +736,23 @@ resource "vault_jwt_auth_backend_role" "role" {
   max_ttl = 10800
 }`, backend, role)
 }
+
+func TestJWTAuthBackendRoleBoundClaimDiffSuppress(t *testing.T) {
+	tests := []struct {
+		k, old, new string
+		expected    bool
+	}{
+		{"bound_claims.department", "engineering,admin", "engineering, admin", true},
+		{"bound_claims.department", "engineering,admin", "engineering,admin", true},
+		{"bound_claims.department", "engineering,admin", "admin,engineering", false},
+		{"bound_claims.department", "engineering", "engineering,admin", false},
+		{"bound_claims.department", "", "engineering", false},
+		{"bound_claims.%", "2", "2", false},
+	}
+
+	for _, test := range tests {
+		if actual := jwtAuthBackendRoleBoundClaimDiffSuppress(test.k, test.old, test.new, nil); actual != test.expected {
+			t.Errorf("expected %t for %q and %q, got %t", test.expected, test.old, test.new, actual)
+		}
+	}
+}
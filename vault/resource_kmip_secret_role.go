@@ -0,0 +1,201 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+// kmipSecretRoleOperations are the KMIP operations a role can be allowed to
+// perform. Vault only returns the ones that are allowed.
+var kmipSecretRoleOperations = []string{
+	"operation_all",
+	"operation_none",
+	"operation_activate",
+	"operation_add_attribute",
+	"operation_create",
+	"operation_destroy",
+	"operation_discover_versions",
+	"operation_get",
+	"operation_get_attribute_list",
+	"operation_get_attributes",
+	"operation_locate",
+	"operation_register",
+	"operation_rekey",
+	"operation_revoke",
+}
+
+func kmipSecretRoleResource() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"backend": {
+			Type:        schema.TypeString,
+			Required:    true,
+			ForceNew:    true,
+			Description: "The path of the KMIP secret backend the role belongs to.",
+			// standardise on no beginning or trailing slashes
+			StateFunc: func(v interface{}) string {
+				return strings.Trim(v.(string), "/")
+			},
+		},
+		"scope": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			Description:  "Name of the scope the role belongs to.",
+			ValidateFunc: validateNoTrailingSlash,
+		},
+		"role": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			Description:  "Name of the role.",
+			ValidateFunc: validateNoTrailingSlash,
+		},
+		"tls_client_key_type": {
+			Type:         schema.TypeString,
+			Optional:     true,
+			Computed:     true,
+			Description:  "The type of key of client certificates. One of \"rsa\" or \"ec\". Defaults to the backend's setting.",
+			ValidateFunc: validation.StringInSlice([]string{"rsa", "ec"}, false),
+		},
+		"tls_client_key_bits": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "The size in bits of the key of client certificates. Defaults to the backend's setting.",
+		},
+		"tls_client_ttl": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "The TTL of client certificates, in seconds. Defaults to the backend's setting.",
+		},
+	}
+	for _, op := range kmipSecretRoleOperations {
+		s[op] = &schema.Schema{
+			Type:        schema.TypeBool,
+			Optional:    true,
+			Description: fmt.Sprintf("Allow the %q KMIP operation.", strings.TrimPrefix(op, "operation_")),
+		}
+	}
+	s["operation_all"].Description = "Allow all KMIP operations."
+	s["operation_none"].Description = "Allow no KMIP operations."
+
+	return &schema.Resource{
+		Create: kmipSecretRoleWrite,
+		Read:   kmipSecretRoleRead,
+		Update: kmipSecretRoleWrite,
+		Delete: kmipSecretRoleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: s,
+	}
+}
+
+func kmipSecretRoleWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := strings.Trim(d.Get("backend").(string), "/")
+	path := kmipSecretRolePath(backend, d.Get("scope").(string), d.Get("role").(string))
+
+	data := map[string]interface{}{}
+	for _, op := range kmipSecretRoleOperations {
+		data[op] = d.Get(op).(bool)
+	}
+	for _, k := range []string{"tls_client_key_type", "tls_client_key_bits", "tls_client_ttl"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Writing KMIP role %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing KMIP role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote KMIP role %q", path)
+
+	d.SetId(path)
+
+	return kmipSecretRoleRead(d, meta)
+}
+
+func kmipSecretRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	backend, scope, role, err := kmipSecretRoleParsePath(path)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Reading KMIP role %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading KMIP role %q: %s", path, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] KMIP role %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+	log.Printf("[DEBUG] Read KMIP role %q", path)
+
+	d.Set("backend", backend)
+	d.Set("scope", scope)
+	d.Set("role", role)
+	for _, op := range kmipSecretRoleOperations {
+		v, _ := resp.Data[op].(bool)
+		d.Set(op, v)
+	}
+	d.Set("tls_client_key_type", resp.Data["tls_client_key_type"])
+
+	for _, k := range []string{"tls_client_key_bits", "tls_client_ttl"} {
+		if v, ok := resp.Data[k].(json.Number); ok {
+			n, err := v.Int64()
+			if err != nil {
+				return fmt.Errorf("expected %s of %q to be a number, got %q", k, path, v)
+			}
+			d.Set(k, n)
+		}
+	}
+
+	return nil
+}
+
+func kmipSecretRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting KMIP role %q", path)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return fmt.Errorf("error deleting KMIP role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted KMIP role %q", path)
+
+	return nil
+}
+
+func kmipSecretRolePath(backend, scope, role string) string {
+	return kmipSecretScopePath(backend, scope) + "/role/" + strings.Trim(role, "/")
+}
+
+func kmipSecretRoleParsePath(path string) (string, string, string, error) {
+	i := strings.LastIndex(path, "/role/")
+	if i <= 0 || i+len("/role/") == len(path) {
+		return "", "", "", fmt.Errorf("expected a path of the form <backend>/scope/<scope>/role/<role>, got %q", path)
+	}
+	backend, scope, err := kmipSecretScopeParsePath(path[:i])
+	if err != nil {
+		return "", "", "", fmt.Errorf("expected a path of the form <backend>/scope/<scope>/role/<role>, got %q", path)
+	}
+
+	return backend, scope, path[i+len("/role/"):], nil
+}
@@ -0,0 +1,123 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccKMIPSecretRole(t *testing.T) {
+	if os.Getenv("TF_ACC_ENTERPRISE") == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+	backend := acctest.RandomWithPrefix("tf-test-kmip")
+	resourceName := "vault_kmip_secret_role.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccKMIPSecretRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKMIPSecretRoleConfig(backend, `
+  operation_activate = true
+  operation_get      = true
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", backend+"/scope/storage/role/app"),
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "scope", "storage"),
+					resource.TestCheckResourceAttr(resourceName, "role", "app"),
+					resource.TestCheckResourceAttr(resourceName, "operation_activate", "true"),
+					resource.TestCheckResourceAttr(resourceName, "operation_get", "true"),
+					resource.TestCheckResourceAttr(resourceName, "operation_all", "false"),
+					resource.TestCheckResourceAttrSet("data.vault_kmip_secret_credentials.test", "certificate"),
+					resource.TestCheckResourceAttrSet("data.vault_kmip_secret_credentials.test", "private_key"),
+					resource.TestCheckResourceAttrSet("data.vault_kmip_secret_credentials.test", "serial_number"),
+				),
+			},
+			{
+				Config: testAccKMIPSecretRoleConfig(backend, `
+  operation_all       = true
+  tls_client_key_type = "ec"
+  tls_client_key_bits = 256
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "operation_all", "true"),
+					resource.TestCheckResourceAttr(resourceName, "operation_get", "false"),
+					resource.TestCheckResourceAttr(resourceName, "tls_client_key_type", "ec"),
+					resource.TestCheckResourceAttr(resourceName, "tls_client_key_bits", "256"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestKMIPSecretRoleParsePath(t *testing.T) {
+	backend, scope, role, err := kmipSecretRoleParsePath("kmip/scope/storage/role/app")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if backend != "kmip" || scope != "storage" || role != "app" {
+		t.Errorf("expected %q, %q and %q, got %q, %q and %q", "kmip", "storage", "app", backend, scope, role)
+	}
+
+	for _, path := range []string{"kmip/scope/storage/role/", "kmip/scope/storage", "kmip/role/app"} {
+		if _, _, _, err := kmipSecretRoleParsePath(path); err == nil {
+			t.Errorf("expected an error parsing %q", path)
+		}
+	}
+}
+
+func testAccKMIPSecretRoleCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_kmip_secret_role" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			// The backend is gone along with its roles.
+			continue
+		}
+		if secret != nil {
+			return fmt.Errorf("role %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccKMIPSecretRoleConfig(backend, operations string) string {
+	return testAccKMIPSecretBackendConfig(backend) + fmt.Sprintf(`
+resource "vault_kmip_secret_scope" "test" {
+  backend = vault_mount.test.path
+  scope   = "storage"
+  force   = true
+
+  depends_on = [vault_generic_endpoint.config]
+}
+
+resource "vault_kmip_secret_role" "test" {
+  backend = vault_kmip_secret_scope.test.backend
+  scope   = vault_kmip_secret_scope.test.scope
+  role    = "app"
+%s}
+
+data "vault_kmip_secret_credentials" "test" {
+  backend = vault_kmip_secret_role.test.backend
+  scope   = vault_kmip_secret_role.test.scope
+  role    = vault_kmip_secret_role.test.role
+}
+`, operations)
+}
@@ -0,0 +1,140 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func kmipSecretScopeResource() *schema.Resource {
+	return &schema.Resource{
+		Create: kmipSecretScopeCreate,
+		Read:   kmipSecretScopeRead,
+		Update: kmipSecretScopeUpdate,
+		Delete: kmipSecretScopeDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the KMIP secret backend the scope belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"scope": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the scope.",
+				ValidateFunc: validateNoTrailingSlash,
+			},
+			"force": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Delete the scope even if it still has roles or managed objects.",
+			},
+		},
+	}
+}
+
+func kmipSecretScopeCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := strings.Trim(d.Get("backend").(string), "/")
+	path := kmipSecretScopePath(backend, d.Get("scope").(string))
+
+	log.Printf("[DEBUG] Creating KMIP scope %q", path)
+	if _, err := client.Logical().Write(path, nil); err != nil {
+		return fmt.Errorf("error creating KMIP scope %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Created KMIP scope %q", path)
+
+	d.SetId(path)
+
+	return kmipSecretScopeRead(d, meta)
+}
+
+func kmipSecretScopeRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	backend, scope, err := kmipSecretScopeParsePath(path)
+	if err != nil {
+		return err
+	}
+
+	// Scopes have no settings to read, they can only be listed.
+	listPath := backend + "/scope"
+	log.Printf("[DEBUG] Listing KMIP scopes at %q", listPath)
+	resp, err := client.Logical().List(listPath)
+	if err != nil {
+		return fmt.Errorf("error listing KMIP scopes at %q: %s", listPath, err)
+	}
+
+	found := false
+	if resp != nil {
+		if keys, ok := resp.Data["keys"].([]interface{}); ok {
+			for _, k := range keys {
+				if k == scope {
+					found = true
+					break
+				}
+			}
+		}
+	}
+	if !found {
+		log.Printf("[WARN] KMIP scope %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("scope", scope)
+
+	return nil
+}
+
+func kmipSecretScopeUpdate(d *schema.ResourceData, meta interface{}) error {
+	// Only force can change, which is used on delete.
+	return kmipSecretScopeRead(d, meta)
+}
+
+func kmipSecretScopeDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	data := map[string][]string{}
+	if d.Get("force").(bool) {
+		data["force"] = []string{"true"}
+	}
+
+	log.Printf("[DEBUG] Deleting KMIP scope %q", path)
+	if _, err := client.Logical().DeleteWithData(path, data); err != nil {
+		return fmt.Errorf("error deleting KMIP scope %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted KMIP scope %q", path)
+
+	return nil
+}
+
+func kmipSecretScopePath(backend, scope string) string {
+	return strings.Trim(backend, "/") + "/scope/" + strings.Trim(scope, "/")
+}
+
+func kmipSecretScopeParsePath(path string) (string, string, error) {
+	i := strings.LastIndex(path, "/scope/")
+	if i <= 0 || i+len("/scope/") == len(path) || strings.Contains(path[i+len("/scope/"):], "/") {
+		return "", "", fmt.Errorf("expected a path of the form <backend>/scope/<scope>, got %q", path)
+	}
+
+	return path[:i], path[i+len("/scope/"):], nil
+}
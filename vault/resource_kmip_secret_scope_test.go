@@ -0,0 +1,111 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccKMIPSecretScope(t *testing.T) {
+	if os.Getenv("TF_ACC_ENTERPRISE") == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+	backend := acctest.RandomWithPrefix("tf-test-kmip")
+	resourceName := "vault_kmip_secret_scope.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccKMIPSecretScopeCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKMIPSecretBackendConfig(backend) + `
+resource "vault_kmip_secret_scope" "test" {
+  backend = vault_mount.test.path
+  scope   = "storage"
+  force   = true
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", backend+"/scope/storage"),
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "scope", "storage"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"force"},
+			},
+		},
+	})
+}
+
+func TestKMIPSecretScopeParsePath(t *testing.T) {
+	backend, scope, err := kmipSecretScopeParsePath("kmip/nested/scope/storage")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if backend != "kmip/nested" || scope != "storage" {
+		t.Errorf("expected backend %q and scope %q, got %q and %q", "kmip/nested", "storage", backend, scope)
+	}
+
+	for _, path := range []string{"kmip/scope/", "scope/storage", "kmip/storage", "kmip/scope/storage/role/x"} {
+		if _, _, err := kmipSecretScopeParsePath(path); err == nil {
+			t.Errorf("expected an error parsing %q", path)
+		}
+	}
+}
+
+func testAccKMIPSecretScopeCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_kmip_secret_scope" {
+			continue
+		}
+		backend, scope, err := kmipSecretScopeParsePath(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		resp, err := client.Logical().List(backend + "/scope")
+		if err != nil {
+			// The backend is gone along with its scopes.
+			continue
+		}
+		if resp == nil {
+			continue
+		}
+		keys, _ := resp.Data["keys"].([]interface{})
+		for _, k := range keys {
+			if k == scope {
+				return fmt.Errorf("scope %q still exists", rs.Primary.ID)
+			}
+		}
+	}
+	return nil
+}
+
+func testAccKMIPSecretBackendConfig(backend string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "kmip"
+}
+
+resource "vault_generic_endpoint" "config" {
+  path                 = "${vault_mount.test.path}/config"
+  ignore_absent_fields = true
+  disable_read         = true
+  data_json            = jsonencode({
+    listen_addrs = ["127.0.0.1:5696"]
+  })
+}
+`, backend)
+}
@@ -159,6 +159,14 @@ func kubernetesAuthBackendRoleUpdateFields(d *schema.ResourceData, data map[stri
 		data["period"] = v.(int)
 	}
 
+	if v, ok := d.GetOk("num_uses"); ok {
+		data["num_uses"] = v.(int)
+	}
+
+	if v, ok := d.GetOk("bound_cidrs"); ok {
+		data["bound_cidrs"] = v.(*schema.Set).List()
+	}
+
 	if create {
 		if v, ok := d.GetOk("audience"); ok {
 			data["audience"] = v.(string)
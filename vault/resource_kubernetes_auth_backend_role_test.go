@@ -455,6 +455,10 @@ func TestAccKubernetesAuthBackendRole_fullDeprecated(t *testing.T) {
 						"max_ttl", strconv.Itoa(oldMaxTTL)),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
 						"period", "900"),
+					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
+						"num_uses", "12"),
+					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
+						"bound_cidrs.#", "1"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
 						"audience", oldAudience),
 				),
@@ -488,6 +492,10 @@ func TestAccKubernetesAuthBackendRole_fullDeprecated(t *testing.T) {
 						"max_ttl", strconv.Itoa(newMaxTTL)),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
 						"period", "900"),
+					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
+						"num_uses", "12"),
+					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
+						"bound_cidrs.#", "1"),
 					resource.TestCheckResourceAttr("vault_kubernetes_auth_backend_role.role",
 						"audience", newAudience),
 				),
@@ -584,6 +592,8 @@ resource "vault_kubernetes_auth_backend_role" "role" {
   ttl = %d
   max_ttl = %d
   period = 900
+  num_uses = 12
+  bound_cidrs = ["10.148.0.0/20"]
   policies = ["default", "dev", "prod"]
   audience = %q
 }`, backend, role, ttl, maxTTL, audience)
@@ -0,0 +1,231 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	kubernetesSecretBackendRoleAccountFields   = []string{"service_account_name", "kubernetes_role_name", "generated_role_rules"}
+	kubernetesSecretBackendRoleNamespaceFields = []string{"allowed_kubernetes_namespaces", "allowed_kubernetes_namespace_selector"}
+)
+
+func kubernetesSecretBackendRoleResource() *schema.Resource {
+	return &schema.Resource{
+		Create: kubernetesSecretBackendRoleWrite,
+		Read:   kubernetesSecretBackendRoleRead,
+		Update: kubernetesSecretBackendRoleWrite,
+		Delete: kubernetesSecretBackendRoleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the Kubernetes secret backend the role belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the role.",
+				ValidateFunc: validateNoTrailingSlash,
+			},
+			"allowed_kubernetes_namespaces": {
+				Type:         schema.TypeSet,
+				Optional:     true,
+				AtLeastOneOf: kubernetesSecretBackendRoleNamespaceFields,
+				Description:  "The Kubernetes namespaces credentials may be requested in. \"*\" allows all namespaces.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"allowed_kubernetes_namespace_selector": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				AtLeastOneOf:     kubernetesSecretBackendRoleNamespaceFields,
+				Description:      "A label selector, in YAML or JSON, for the Kubernetes namespaces credentials may be requested in. Requires Vault 1.14 or later.",
+				DiffSuppressFunc: yamlOrJSONDiffSuppress,
+			},
+			"token_default_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The default TTL of generated service account tokens, in seconds. Defaults to the backend's default lease TTL.",
+			},
+			"token_max_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The maximum TTL of generated service account tokens, in seconds. Defaults to the backend's max lease TTL.",
+			},
+			"service_account_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: kubernetesSecretBackendRoleAccountFields,
+				Description:  "The existing Kubernetes service account to generate tokens for.",
+			},
+			"kubernetes_role_name": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ExactlyOneOf: kubernetesSecretBackendRoleAccountFields,
+				Description:  "The existing Kubernetes Role or ClusterRole to bind to a service account generated for each token.",
+			},
+			"generated_role_rules": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ExactlyOneOf:     kubernetesSecretBackendRoleAccountFields,
+				Description:      "The RBAC rules, in YAML or JSON, of a Role or ClusterRole generated along with a service account for each token.",
+				ValidateFunc:     validateKubernetesRoleRules,
+				DiffSuppressFunc: yamlOrJSONDiffSuppress,
+			},
+			"kubernetes_role_type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Default:      "Role",
+				Description:  "The type of the Kubernetes role used with kubernetes_role_name or generated_role_rules. One of \"Role\" or \"ClusterRole\".",
+				ValidateFunc: validation.StringInSlice([]string{"Role", "ClusterRole"}, false),
+			},
+			"name_template": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The template used to name the Kubernetes objects generated for each token.",
+			},
+			"extra_annotations": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Additional annotations to apply to the Kubernetes objects generated for each token.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"extra_labels": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Additional labels to apply to the Kubernetes objects generated for each token.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func kubernetesSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := strings.Trim(d.Get("backend").(string), "/")
+	path := kubernetesSecretBackendRolePath(backend, d.Get("name").(string))
+
+	data := map[string]interface{}{
+		"allowed_kubernetes_namespaces": d.Get("allowed_kubernetes_namespaces").(*schema.Set).List(),
+		"token_default_ttl":             d.Get("token_default_ttl").(int),
+		"token_max_ttl":                 d.Get("token_max_ttl").(int),
+		"kubernetes_role_type":          d.Get("kubernetes_role_type").(string),
+		"extra_annotations":             d.Get("extra_annotations"),
+		"extra_labels":                  d.Get("extra_labels"),
+	}
+	// Vault keeps the fields that aren't sent, so the ones that were removed
+	// are cleared explicitly.
+	for _, k := range append(kubernetesSecretBackendRoleAccountFields, "allowed_kubernetes_namespace_selector", "name_template") {
+		if v, ok := d.GetOk(k); ok || d.HasChange(k) {
+			data[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Writing Kubernetes secret backend role %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing Kubernetes secret backend role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote Kubernetes secret backend role %q", path)
+
+	d.SetId(path)
+
+	return kubernetesSecretBackendRoleRead(d, meta)
+}
+
+func kubernetesSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	backend, name, err := kubernetesSecretBackendRoleParsePath(path)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Reading Kubernetes secret backend role %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading Kubernetes secret backend role %q: %s", path, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] Kubernetes secret backend role %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+	log.Printf("[DEBUG] Read Kubernetes secret backend role %q", path)
+
+	d.Set("backend", backend)
+	d.Set("name", name)
+
+	for _, k := range []string{"token_default_ttl", "token_max_ttl"} {
+		var n int64
+		if v, ok := resp.Data[k].(json.Number); ok {
+			n, err = v.Int64()
+			if err != nil {
+				return fmt.Errorf("expected %s of %q to be a number, got %q", k, path, v)
+			}
+		}
+		d.Set(k, n)
+	}
+
+	for _, k := range []string{
+		"allowed_kubernetes_namespaces", "allowed_kubernetes_namespace_selector",
+		"service_account_name", "kubernetes_role_name", "generated_role_rules",
+		"kubernetes_role_type", "name_template", "extra_annotations", "extra_labels",
+	} {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting %s for Kubernetes secret backend role %q: %s", k, path, err)
+		}
+	}
+
+	return nil
+}
+
+func kubernetesSecretBackendRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting Kubernetes secret backend role %q", path)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return fmt.Errorf("error deleting Kubernetes secret backend role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted Kubernetes secret backend role %q", path)
+
+	return nil
+}
+
+func kubernetesSecretBackendRolePath(backend, name string) string {
+	return strings.Trim(backend, "/") + "/roles/" + strings.Trim(name, "/")
+}
+
+func kubernetesSecretBackendRoleParsePath(path string) (string, string, error) {
+	i := strings.LastIndex(path, "/roles/")
+	if i <= 0 || i+len("/roles/") == len(path) {
+		return "", "", fmt.Errorf("expected a path of the form <backend>/roles/<name>, got %q", path)
+	}
+
+	return path[:i], path[i+len("/roles/"):], nil
+}
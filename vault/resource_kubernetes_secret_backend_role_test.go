@@ -0,0 +1,180 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+// getTestKubernetesConfig returns the host, CA certificate and service account
+// JWT Vault uses to talk to the Kubernetes cluster the tests run against. The
+// service account needs the permissions listed at
+// https://www.vaultproject.io/docs/secrets/kubernetes#kubernetes-setup.
+func getTestKubernetesConfig(t *testing.T) (string, string, string) {
+	host := os.Getenv("KUBE_HOST")
+	if host == "" {
+		t.Skip("KUBE_HOST not set")
+	}
+	return host, os.Getenv("KUBE_CA_CERT"), os.Getenv("KUBE_SA_JWT")
+}
+
+func TestAccKubernetesSecretBackendRole(t *testing.T) {
+	host, caCert, jwt := getTestKubernetesConfig(t)
+	backend := acctest.RandomWithPrefix("tf-test-kubernetes")
+	name := acctest.RandomWithPrefix("role")
+	resourceName := "vault_kubernetes_secret_backend_role.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccKubernetesSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesSecretBackendRoleConfig(backend, host, caCert, jwt, name, `
+  allowed_kubernetes_namespaces = ["default"]
+  token_default_ttl             = 600
+  token_max_ttl                 = 3600
+  service_account_name          = "default"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "allowed_kubernetes_namespaces.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "token_default_ttl", "600"),
+					resource.TestCheckResourceAttr(resourceName, "token_max_ttl", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "service_account_name", "default"),
+					resource.TestCheckResourceAttr(resourceName, "generated_role_rules", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccKubernetesSecretBackendRoleConfig(backend, host, caCert, jwt, name, `
+  allowed_kubernetes_namespaces = ["*"]
+  kubernetes_role_type          = "ClusterRole"
+  name_template                 = "vault-{{.RoleName}}-{{random 8}}"
+  generated_role_rules          = <<EOT
+rules:
+- apiGroups: [""]
+  resources: ["pods"]
+  verbs: ["list"]
+EOT
+
+  extra_labels = {
+    team = "platform"
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "allowed_kubernetes_namespaces.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "token_default_ttl", "0"),
+					resource.TestCheckResourceAttr(resourceName, "service_account_name", ""),
+					resource.TestCheckResourceAttr(resourceName, "kubernetes_role_type", "ClusterRole"),
+					resource.TestCheckResourceAttr(resourceName, "extra_labels.team", "platform"),
+					resource.TestCheckResourceAttrSet(resourceName, "generated_role_rules"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccKubernetesServiceAccountToken(t *testing.T) {
+	host, caCert, jwt := getTestKubernetesConfig(t)
+	backend := acctest.RandomWithPrefix("tf-test-kubernetes")
+	name := acctest.RandomWithPrefix("role")
+	dataSourceName := "data.vault_kubernetes_service_account_token.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccKubernetesSecretBackendRoleConfig(backend, host, caCert, jwt, name, `
+  allowed_kubernetes_namespaces = ["default"]
+  service_account_name          = "default"
+`) + `
+data "vault_kubernetes_service_account_token" "test" {
+  backend              = vault_mount.test.path
+  role                 = vault_kubernetes_secret_backend_role.test.name
+  kubernetes_namespace = "default"
+  ttl                  = "1h"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "service_account_name", "default"),
+					resource.TestCheckResourceAttr(dataSourceName, "service_account_namespace", "default"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "service_account_token"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "lease_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "lease_duration", "3600"),
+				),
+			},
+		},
+	})
+}
+
+func TestKubernetesSecretBackendRoleParsePath(t *testing.T) {
+	backend, name, err := kubernetesSecretBackendRoleParsePath("kubernetes/nested/roles/ci")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if backend != "kubernetes/nested" || name != "ci" {
+		t.Errorf("expected backend %q and name %q, got %q and %q", "kubernetes/nested", "ci", backend, name)
+	}
+
+	for _, path := range []string{"kubernetes/roles/", "roles/ci", "kubernetes/ci"} {
+		if _, _, err := kubernetesSecretBackendRoleParsePath(path); err == nil {
+			t.Errorf("expected an error parsing %q", path)
+		}
+	}
+}
+
+func testAccKubernetesSecretBackendRoleCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_kubernetes_secret_backend_role" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("role %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccKubernetesSecretBackendRoleConfig(backend, host, caCert, jwt, name, role string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "kubernetes"
+}
+
+resource "vault_generic_endpoint" "config" {
+  path                 = "${vault_mount.test.path}/config"
+  ignore_absent_fields = true
+  data_json            = jsonencode({
+    kubernetes_host     = %q
+    kubernetes_ca_cert  = %q
+    service_account_jwt = %q
+  })
+}
+
+resource "vault_kubernetes_secret_backend_role" "test" {
+  backend    = vault_mount.test.path
+  name       = "%s"
+  depends_on = [vault_generic_endpoint.config]
+%s
+}
+`, backend, host, caCert, jwt, name, role)
+}
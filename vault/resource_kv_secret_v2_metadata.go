@@ -0,0 +1,207 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func kvSecretV2MetadataResource() *schema.Resource {
+	return &schema.Resource{
+		Create: kvSecretV2MetadataWrite,
+		Read:   kvSecretV2MetadataRead,
+		Update: kvSecretV2MetadataWrite,
+		Delete: kvSecretV2MetadataDelete,
+		Importer: &schema.ResourceImporter{
+			State: kvSecretV2MetadataImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"mount": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Path where the KV v2 secrets engine is mounted.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Path of the secret within the mount.",
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"max_versions": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The number of versions to keep for the secret. If 0, the mount's setting is used.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"cas_required": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "If true, writes to the secret require the cas parameter.",
+			},
+			"delete_version_after_seconds": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Number of seconds after which versions of the secret are deleted. If 0, versions are never deleted.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"custom_metadata": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "A map of arbitrary string to string values to store with the secret.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"delete_all_versions": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If true, destroying the resource deletes the metadata along with all versions of the secret. Otherwise the metadata is only reset to its defaults.",
+			},
+		},
+	}
+}
+
+func kvSecretV2MetadataPath(mount, name string) string {
+	return strings.Trim(mount, "/") + "/metadata/" + strings.Trim(name, "/")
+}
+
+func kvSecretV2MetadataWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := kvSecretV2MetadataPath(d.Get("mount").(string), d.Get("name").(string))
+
+	data := map[string]interface{}{
+		"max_versions":         d.Get("max_versions").(int),
+		"cas_required":         d.Get("cas_required").(bool),
+		"delete_version_after": fmt.Sprintf("%ds", d.Get("delete_version_after_seconds").(int)),
+		"custom_metadata":      d.Get("custom_metadata").(map[string]interface{}),
+	}
+
+	log.Printf("[DEBUG] Writing KV v2 metadata to %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing KV v2 metadata to %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote KV v2 metadata to %q", path)
+
+	d.SetId(path)
+	return kvSecretV2MetadataRead(d, meta)
+}
+
+func kvSecretV2MetadataRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading KV v2 metadata from %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading KV v2 metadata from %q: %s", path, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] KV v2 metadata %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	maxVersions, err := resp.Data["max_versions"].(json.Number).Int64()
+	if err != nil {
+		return fmt.Errorf("expected max_versions %q to be a number, and it isn't", resp.Data["max_versions"])
+	}
+	deleteVersionAfter, err := time.ParseDuration(resp.Data["delete_version_after"].(string))
+	if err != nil {
+		return fmt.Errorf("expected delete_version_after %q to be a duration, and it isn't", resp.Data["delete_version_after"])
+	}
+	// Vault returns null rather than an empty map when no custom metadata is set.
+	customMetadata := map[string]interface{}{}
+	if v, ok := resp.Data["custom_metadata"].(map[string]interface{}); ok {
+		customMetadata = v
+	}
+
+	d.Set("max_versions", maxVersions)
+	d.Set("cas_required", resp.Data["cas_required"])
+	d.Set("delete_version_after_seconds", int64(deleteVersionAfter.Seconds()))
+	if err := d.Set("custom_metadata", customMetadata); err != nil {
+		return fmt.Errorf("error setting custom_metadata for %q: %s", path, err)
+	}
+
+	return nil
+}
+
+func kvSecretV2MetadataDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	deleteAll := d.Get("delete_all_versions").(bool)
+	if !deleteAll {
+		// Without any versions there is nothing to preserve.
+		resp, err := client.Logical().Read(path)
+		if err != nil {
+			return fmt.Errorf("error reading KV v2 metadata from %q: %s", path, err)
+		}
+		if resp == nil {
+			return nil
+		}
+		if versions, ok := resp.Data["versions"].(map[string]interface{}); !ok || len(versions) == 0 {
+			deleteAll = true
+		}
+	}
+
+	if deleteAll {
+		log.Printf("[DEBUG] Deleting KV v2 metadata and all versions at %q", path)
+		if _, err := client.Logical().Delete(path); err != nil {
+			return fmt.Errorf("error deleting KV v2 metadata at %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Deleted KV v2 metadata and all versions at %q", path)
+		return nil
+	}
+
+	// Deleting the metadata would also destroy every version of the secret,
+	// so by default only reset it to the defaults.
+	data := map[string]interface{}{
+		"max_versions":         0,
+		"cas_required":         false,
+		"delete_version_after": "0s",
+		"custom_metadata":      map[string]interface{}{},
+	}
+	log.Printf("[DEBUG] Resetting KV v2 metadata at %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error resetting KV v2 metadata at %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Reset KV v2 metadata at %q", path)
+
+	return nil
+}
+
+func kvSecretV2MetadataImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	parts := strings.SplitN(strings.Trim(d.Id(), "/"), "/metadata/", 2)
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return nil, fmt.Errorf("expected import ID in the format <mount>/metadata/<name>, got %q", d.Id())
+	}
+
+	d.Set("mount", parts[0])
+	d.Set("name", parts[1])
+	d.Set("delete_all_versions", false)
+	d.SetId(kvSecretV2MetadataPath(parts[0], parts[1]))
+
+	return []*schema.ResourceData{d}, nil
+}
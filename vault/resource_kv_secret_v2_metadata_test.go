@@ -0,0 +1,133 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestKVSecretV2Metadata(t *testing.T) {
+	if os.Getenv(resource.TestEnvVar) == "" {
+		t.Skip(fmt.Sprintf("Acceptance tests skipped unless env '%s' set", resource.TestEnvVar))
+	}
+	testAccPreCheck(t)
+
+	client := testAccClient(t)
+
+	mount := acctest.RandomWithPrefix("kvv2")
+	name := "team/" + acctest.RandomWithPrefix("secret")
+	if err := client.Sys().Mount(mount, &api.MountInput{
+		Type:    "kv",
+		Options: map[string]string{"version": "2"},
+	}); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := client.Sys().Unmount(mount); err != nil {
+			t.Error(err)
+		}
+	}()
+	// The mount is upgraded to v2 asynchronously, so retry the first write.
+	err := resource.Retry(mountDisableRetryTimeout, func() *resource.RetryError {
+		_, err := client.Logical().Write(mount+"/data/"+name, map[string]interface{}{
+			"data": map[string]interface{}{"hello": "world"},
+		})
+		if err != nil {
+			return resource.RetryableError(err)
+		}
+		return nil
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	resourceName := "vault_kv_secret_v2_metadata.test"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		CheckDestroy: testKVSecretV2MetadataCheckDestroy(client, kvSecretV2MetadataPath(mount, name)),
+		Steps: []resource.TestStep{
+			{
+				Config: testKVSecretV2MetadataConfig(mount, name, "team-a"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", kvSecretV2MetadataPath(mount, name)),
+					resource.TestCheckResourceAttr(resourceName, "max_versions", "5"),
+					resource.TestCheckResourceAttr(resourceName, "cas_required", "true"),
+					resource.TestCheckResourceAttr(resourceName, "delete_version_after_seconds", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "custom_metadata.%", "1"),
+					resource.TestCheckResourceAttr(resourceName, "custom_metadata.owner", "team-a"),
+				),
+			},
+			{
+				Config: testKVSecretV2MetadataConfig(mount, name, "team-b"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "custom_metadata.owner", "team-b"),
+			},
+			{
+				// Changes made outside of Terraform must show up as drift.
+				PreConfig: func() {
+					_, err := client.Logical().Write(kvSecretV2MetadataPath(mount, name), map[string]interface{}{
+						"custom_metadata": map[string]interface{}{"owner": "someone-else"},
+					})
+					if err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:             testKVSecretV2MetadataConfig(mount, name, "team-b"),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+			{
+				Config: testKVSecretV2MetadataConfig(mount, name, "team-b"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "custom_metadata.owner", "team-b"),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+// testKVSecretV2MetadataCheckDestroy ensures the metadata was reset while the
+// secret's versions were preserved.
+func testKVSecretV2MetadataCheckDestroy(client *api.Client, path string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		resp, err := client.Logical().Read(path)
+		if err != nil {
+			return err
+		}
+		if resp == nil {
+			return fmt.Errorf("expected the versions at %q to be preserved", path)
+		}
+		if versions, ok := resp.Data["versions"].(map[string]interface{}); !ok || len(versions) == 0 {
+			return fmt.Errorf("expected the versions at %q to be preserved", path)
+		}
+		if customMetadata, ok := resp.Data["custom_metadata"].(map[string]interface{}); ok && len(customMetadata) > 0 {
+			return fmt.Errorf("expected custom_metadata at %q to be reset, got %v", path, customMetadata)
+		}
+		if resp.Data["cas_required"].(bool) {
+			return fmt.Errorf("expected cas_required at %q to be reset", path)
+		}
+		return nil
+	}
+}
+
+func testKVSecretV2MetadataConfig(mount, name, owner string) string {
+	return fmt.Sprintf(`
+resource "vault_kv_secret_v2_metadata" "test" {
+  mount                        = "%s"
+  name                         = "%s"
+  max_versions                 = 5
+  cas_required                 = true
+  delete_version_after_seconds = 3600
+  custom_metadata = {
+    owner = "%s"
+  }
+}
+`, mount, name, owner)
+}
@@ -82,10 +82,17 @@ func ldapAuthBackendResource() *schema.Resource {
 			Optional: true,
 			Computed: true,
 		},
+		"userfilter": {
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			DiffSuppressFunc: ldapAuthBackendFilterDiffSuppress,
+		},
 		"groupfilter": {
-			Type:     schema.TypeString,
-			Optional: true,
-			Computed: true,
+			Type:             schema.TypeString,
+			Optional:         true,
+			Computed:         true,
+			DiffSuppressFunc: ldapAuthBackendFilterDiffSuppress,
 		},
 		"groupdn": {
 			Type:     schema.TypeString,
@@ -102,6 +109,11 @@ func ldapAuthBackendResource() *schema.Resource {
 			Optional: true,
 			Computed: true,
 		},
+		"case_sensitive_names": {
+			Type:     schema.TypeBool,
+			Optional: true,
+			Computed: true,
+		},
 
 		"description": {
 			Type:     schema.TypeString,
@@ -235,8 +247,12 @@ func ldapAuthBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 		data["upndomain"] = v.(string)
 	}
 
+	if v, ok := d.GetOk("userfilter"); ok {
+		data["userfilter"] = strings.TrimSpace(v.(string))
+	}
+
 	if v, ok := d.GetOk("groupfilter"); ok {
-		data["groupfilter"] = v.(string)
+		data["groupfilter"] = strings.TrimSpace(v.(string))
 	}
 
 	if v, ok := d.GetOk("groupdn"); ok {
@@ -251,6 +267,10 @@ func ldapAuthBackendUpdate(d *schema.ResourceData, meta interface{}) error {
 		data["use_token_groups"] = v.(bool)
 	}
 
+	if v, ok := d.GetOkExists("case_sensitive_names"); ok {
+		data["case_sensitive_names"] = v.(bool)
+	}
+
 	if v, ok := d.GetOk("client_tls_cert"); ok {
 		data["client_tls_cert"] = v.(string)
 	}
@@ -323,10 +343,12 @@ func ldapAuthBackendRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("discoverdn", resp.Data["discoverdn"])
 	d.Set("deny_null_bind", resp.Data["deny_null_bind"])
 	d.Set("upndomain", resp.Data["upndomain"])
+	d.Set("userfilter", resp.Data["userfilter"])
 	d.Set("groupfilter", resp.Data["groupfilter"])
 	d.Set("groupdn", resp.Data["groupdn"])
 	d.Set("groupattr", resp.Data["groupattr"])
 	d.Set("use_token_groups", resp.Data["use_token_groups"])
+	d.Set("case_sensitive_names", resp.Data["case_sensitive_names"])
 
 	// `bindpass`, `client_tls_cert` and `client_tls_key` cannot be read out from the API
 	// So... if they drift, they drift.
@@ -334,6 +356,13 @@ func ldapAuthBackendRead(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// ldapAuthBackendFilterDiffSuppress ignores leading and trailing whitespace
+// in LDAP filters, such as the newline ending a heredoc, which is trimmed
+// before the filter is written.
+func ldapAuthBackendFilterDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	return strings.TrimSpace(old) == strings.TrimSpace(new)
+}
+
 func ldapAuthBackendDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 	path := d.Id()
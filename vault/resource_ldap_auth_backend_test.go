@@ -160,22 +160,24 @@ func testLDAPAuthBackendCheck_attrs(path string) resource.TestCheckFunc {
 		}
 
 		attrs := map[string]string{
-			"url":              "url",
-			"starttls":         "starttls",
-			"tls_min_version":  "tls_min_version",
-			"tls_max_version":  "tls_max_version",
-			"insecure_tls":     "insecure_tls",
-			"certificate":      "certificate",
-			"binddn":           "binddn",
-			"userdn":           "userdn",
-			"userattr":         "userattr",
-			"discoverdn":       "discoverdn",
-			"deny_null_bind":   "deny_null_bind",
-			"upndomain":        "upndomain",
-			"groupfilter":      "groupfilter",
-			"groupdn":          "groupdn",
-			"groupattr":        "groupattr",
-			"use_token_groups": "use_token_groups",
+			"url":                  "url",
+			"starttls":             "starttls",
+			"tls_min_version":      "tls_min_version",
+			"tls_max_version":      "tls_max_version",
+			"insecure_tls":         "insecure_tls",
+			"certificate":          "certificate",
+			"binddn":               "binddn",
+			"userdn":               "userdn",
+			"userattr":             "userattr",
+			"discoverdn":           "discoverdn",
+			"deny_null_bind":       "deny_null_bind",
+			"upndomain":            "upndomain",
+			"userfilter":           "userfilter",
+			"groupfilter":          "groupfilter",
+			"groupdn":              "groupdn",
+			"groupattr":            "groupattr",
+			"use_token_groups":     "use_token_groups",
+			"case_sensitive_names": "case_sensitive_names",
 		}
 
 		for stateAttr, apiAttr := range attrs {
@@ -258,6 +260,10 @@ resource "vault_ldap_auth_backend" "test" {
     discoverdn             = false
     deny_null_bind         = true
     description            = "example"
+    case_sensitive_names   = true
+    userfilter             = <<EOT
+({{.UserAttr}}={{.Username}})
+EOT
 
     use_token_groups = %s
 }
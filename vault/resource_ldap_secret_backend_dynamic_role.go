@@ -0,0 +1,158 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func ldapSecretBackendDynamicRoleResource() *schema.Resource {
+	return &schema.Resource{
+		Create: ldapSecretBackendDynamicRoleWrite,
+		Read:   ldapSecretBackendDynamicRoleRead,
+		Update: ldapSecretBackendDynamicRoleWrite,
+		Delete: ldapSecretBackendDynamicRoleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the LDAP secret backend the role belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the role.",
+				ValidateFunc: validateNoTrailingSlash,
+			},
+			"creation_ldif": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The templated LDIF used to create the user entry, and any other entries, for each set of credentials.",
+			},
+			"deletion_ldif": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The templated LDIF used to delete the entries created by creation_ldif when the credentials expire.",
+			},
+			"rollback_ldif": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The templated LDIF used to clean up if creation_ldif fails part way. If not set, deletion_ldif is used.",
+			},
+			"username_template": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The template used to generate usernames.",
+			},
+			"default_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The default TTL of generated credentials, in seconds. Defaults to the backend's default lease TTL.",
+			},
+			"max_ttl": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Description: "The maximum TTL of generated credentials, in seconds. Defaults to the backend's max lease TTL.",
+			},
+		},
+	}
+}
+
+func ldapSecretBackendDynamicRoleWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := strings.Trim(d.Get("backend").(string), "/")
+	path := ldapSecretBackendDynamicRolePath(backend, d.Get("name").(string))
+
+	data := map[string]interface{}{
+		"creation_ldif":     d.Get("creation_ldif").(string),
+		"deletion_ldif":     d.Get("deletion_ldif").(string),
+		"rollback_ldif":     d.Get("rollback_ldif").(string),
+		"username_template": d.Get("username_template").(string),
+		"default_ttl":       d.Get("default_ttl").(int),
+		"max_ttl":           d.Get("max_ttl").(int),
+	}
+
+	log.Printf("[DEBUG] Writing LDAP dynamic role %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing LDAP dynamic role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote LDAP dynamic role %q", path)
+
+	d.SetId(path)
+
+	return ldapSecretBackendDynamicRoleRead(d, meta)
+}
+
+func ldapSecretBackendDynamicRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	backend, name, err := ldapSecretBackendRoleParsePath(path, "role")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Reading LDAP dynamic role %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading LDAP dynamic role %q: %s", path, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] LDAP dynamic role %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+	log.Printf("[DEBUG] Read LDAP dynamic role %q", path)
+
+	d.Set("backend", backend)
+	d.Set("name", name)
+	for _, k := range []string{"creation_ldif", "deletion_ldif", "rollback_ldif", "username_template"} {
+		d.Set(k, resp.Data[k])
+	}
+
+	for _, k := range []string{"default_ttl", "max_ttl"} {
+		var n int64
+		if v, ok := resp.Data[k].(json.Number); ok {
+			n, err = v.Int64()
+			if err != nil {
+				return fmt.Errorf("expected %s of %q to be a number, got %q", k, path, v)
+			}
+		}
+		d.Set(k, n)
+	}
+
+	return nil
+}
+
+func ldapSecretBackendDynamicRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting LDAP dynamic role %q", path)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return fmt.Errorf("error deleting LDAP dynamic role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted LDAP dynamic role %q", path)
+
+	return nil
+}
+
+func ldapSecretBackendDynamicRolePath(backend, name string) string {
+	return strings.Trim(backend, "/") + "/role/" + strings.Trim(name, "/")
+}
@@ -0,0 +1,110 @@
+package vault
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccLDAPSecretBackendDynamicRole(t *testing.T) {
+	url, bindDN, bindPass, userDN := getTestLDAPSecretConfig(t)
+	parentDN := strings.SplitN(userDN, ",", 2)[1]
+	backend := acctest.RandomWithPrefix("tf-test-ldap")
+	name := acctest.RandomWithPrefix("role")
+	resourceName := "vault_ldap_secret_backend_dynamic_role.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccLDAPSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLDAPSecretBackendDynamicRoleConfig(backend, url, bindDN, bindPass, name, parentDN, `
+  default_ttl = 600
+  max_ttl     = 3600
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "default_ttl", "600"),
+					resource.TestCheckResourceAttr(resourceName, "max_ttl", "3600"),
+					resource.TestCheckResourceAttrSet(resourceName, "creation_ldif"),
+					resource.TestCheckResourceAttrSet(resourceName, "deletion_ldif"),
+					resource.TestCheckResourceAttr(resourceName, "rollback_ldif", ""),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccLDAPSecretBackendDynamicRoleConfig(backend, url, bindDN, bindPass, name, parentDN, `
+  username_template = "v_{{.RoleName}}_{{random 8}}"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "default_ttl", "0"),
+					resource.TestCheckResourceAttr(resourceName, "username_template", "v_{{.RoleName}}_{{random 8}}"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLDAPDynamicCredentials(t *testing.T) {
+	url, bindDN, bindPass, userDN := getTestLDAPSecretConfig(t)
+	parentDN := strings.SplitN(userDN, ",", 2)[1]
+	backend := acctest.RandomWithPrefix("tf-test-ldap")
+	name := acctest.RandomWithPrefix("role")
+	dataSourceName := "data.vault_ldap_dynamic_credentials.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLDAPSecretBackendDynamicRoleConfig(backend, url, bindDN, bindPass, name, parentDN, `
+  default_ttl = 3600
+`) + `
+data "vault_ldap_dynamic_credentials" "test" {
+  backend = vault_mount.test.path
+  role    = vault_ldap_secret_backend_dynamic_role.test.name
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "username"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "password"),
+					resource.TestCheckResourceAttr(dataSourceName, "distinguished_names.#", "1"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "lease_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "lease_duration", "3600"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLDAPSecretBackendDynamicRoleConfig(backend, url, bindDN, bindPass, name, parentDN, extra string) string {
+	return testAccLDAPSecretBackendConfig(backend, url, bindDN, bindPass) + fmt.Sprintf(`
+resource "vault_ldap_secret_backend_dynamic_role" "test" {
+  backend       = vault_mount.test.path
+  name          = "%s"
+  creation_ldif = <<EOT
+dn: cn={{.Username}},%s
+objectClass: person
+objectClass: top
+cn: {{.Username}}
+sn: {{.Username}}
+userPassword: {{.Password}}
+EOT
+  deletion_ldif = <<EOT
+dn: cn={{.Username}},%s
+changetype: delete
+EOT
+  depends_on    = [vault_generic_endpoint.config]
+%s
+}
+`, name, parentDN, parentDN, extra)
+}
@@ -0,0 +1,210 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func ldapSecretBackendStaticRoleResource() *schema.Resource {
+	return &schema.Resource{
+		Create: ldapSecretBackendStaticRoleCreate,
+		Read:   ldapSecretBackendStaticRoleRead,
+		Update: ldapSecretBackendStaticRoleUpdate,
+		Delete: ldapSecretBackendStaticRoleDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the LDAP secret backend the static role belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the static role.",
+				ValidateFunc: validateNoTrailingSlash,
+			},
+			"username": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The username of the existing LDAP entry whose password Vault manages.",
+			},
+			"dn": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "The distinguished name of the LDAP entry. If not set, the entry is looked up by username.",
+			},
+			"rotation_period": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  "How often Vault rotates the password, in seconds.",
+				ValidateFunc: validation.IntAtLeast(5),
+			},
+			"rotate_now": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Arbitrary map of values that, when changed, rotates the password immediately instead of waiting for the rotation period.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"last_vault_rotation": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The time Vault last rotated the password.",
+			},
+		},
+	}
+}
+
+func ldapSecretBackendStaticRoleCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := strings.Trim(d.Get("backend").(string), "/")
+	path := ldapSecretBackendStaticRolePath(backend, d.Get("name").(string))
+
+	data := map[string]interface{}{
+		"username":        d.Get("username").(string),
+		"rotation_period": d.Get("rotation_period").(int),
+	}
+	if v, ok := d.GetOk("dn"); ok {
+		data["dn"] = v.(string)
+	}
+
+	// Vault rotates the password when the role is created, so rotate_now is
+	// only acted on when it changes afterwards.
+	log.Printf("[DEBUG] Creating LDAP static role %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error creating LDAP static role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Created LDAP static role %q", path)
+
+	d.SetId(path)
+
+	return ldapSecretBackendStaticRoleRead(d, meta)
+}
+
+func ldapSecretBackendStaticRoleRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	backend, name, err := ldapSecretBackendRoleParsePath(path, "static-role")
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Reading LDAP static role %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading LDAP static role %q: %s", path, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] LDAP static role %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+	log.Printf("[DEBUG] Read LDAP static role %q", path)
+
+	d.Set("backend", backend)
+	d.Set("name", name)
+	for _, k := range []string{"username", "dn", "last_vault_rotation"} {
+		d.Set(k, resp.Data[k])
+	}
+
+	if v, ok := resp.Data["rotation_period"].(json.Number); ok {
+		n, err := v.Int64()
+		if err != nil {
+			return fmt.Errorf("expected rotation_period of %q to be a number, got %q", path, v)
+		}
+		d.Set("rotation_period", n)
+	}
+
+	return nil
+}
+
+func ldapSecretBackendStaticRoleUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	if d.HasChange("rotation_period") {
+		// Vault requires the username on every write, even though it can't
+		// be changed.
+		data := map[string]interface{}{
+			"username":        d.Get("username").(string),
+			"rotation_period": d.Get("rotation_period").(int),
+		}
+		if v, ok := d.GetOk("dn"); ok {
+			data["dn"] = v.(string)
+		}
+
+		log.Printf("[DEBUG] Updating LDAP static role %q", path)
+		if _, err := client.Logical().Write(path, data); err != nil {
+			return fmt.Errorf("error updating LDAP static role %q: %s", path, err)
+		}
+		log.Printf("[DEBUG] Updated LDAP static role %q", path)
+	}
+
+	if d.HasChange("rotate_now") && len(d.Get("rotate_now").(map[string]interface{})) > 0 {
+		backend, name, err := ldapSecretBackendRoleParsePath(path, "static-role")
+		if err != nil {
+			return err
+		}
+		rotatePath := backend + "/rotate-role/" + name
+
+		log.Printf("[DEBUG] Rotating password with %q", rotatePath)
+		if _, err := client.Logical().Write(rotatePath, nil); err != nil {
+			return fmt.Errorf("error rotating password with %q: %s", rotatePath, err)
+		}
+		log.Printf("[DEBUG] Rotated password with %q", rotatePath)
+	}
+
+	return ldapSecretBackendStaticRoleRead(d, meta)
+}
+
+func ldapSecretBackendStaticRoleDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting LDAP static role %q", path)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return fmt.Errorf("error deleting LDAP static role %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted LDAP static role %q", path)
+
+	return nil
+}
+
+func ldapSecretBackendStaticRolePath(backend, name string) string {
+	return strings.Trim(backend, "/") + "/static-role/" + strings.Trim(name, "/")
+}
+
+// ldapSecretBackendRoleParsePath splits the path of a static or dynamic role,
+// where kind is "static-role" or "role", into the backend and the role name.
+func ldapSecretBackendRoleParsePath(path, kind string) (string, string, error) {
+	sep := "/" + kind + "/"
+	i := strings.LastIndex(path, sep)
+	if i <= 0 || i+len(sep) == len(path) {
+		return "", "", fmt.Errorf("expected a path of the form <backend>%s<name>, got %q", sep, path)
+	}
+
+	return path[:i], path[i+len(sep):], nil
+}
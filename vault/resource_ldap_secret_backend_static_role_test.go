@@ -0,0 +1,195 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+// getTestLDAPSecretConfig returns the URL, bind DN and bind password Vault
+// uses to manage the OpenLDAP server the tests run against, and the DN of an
+// existing entry for static roles. Dynamic roles create their entries next to
+// that one.
+func getTestLDAPSecretConfig(t *testing.T) (string, string, string, string) {
+	url := os.Getenv("LDAP_URL")
+	if url == "" {
+		t.Skip("LDAP_URL not set")
+	}
+	userDN := os.Getenv("LDAP_USER_DN")
+	if userDN == "" {
+		t.Skip("LDAP_USER_DN not set")
+	}
+	return url, os.Getenv("LDAP_BINDDN"), os.Getenv("LDAP_BINDPASS"), userDN
+}
+
+func TestAccLDAPSecretBackendStaticRole(t *testing.T) {
+	url, bindDN, bindPass, userDN := getTestLDAPSecretConfig(t)
+	username := strings.SplitN(strings.SplitN(userDN, ",", 2)[0], "=", 2)[1]
+	backend := acctest.RandomWithPrefix("tf-test-ldap")
+	name := acctest.RandomWithPrefix("role")
+	resourceName := "vault_ldap_secret_backend_static_role.test"
+
+	var lastRotation string
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccLDAPSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLDAPSecretBackendStaticRoleConfig(backend, url, bindDN, bindPass, name, username, userDN, 3600, ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "username", username),
+					resource.TestCheckResourceAttr(resourceName, "dn", userDN),
+					resource.TestCheckResourceAttr(resourceName, "rotation_period", "3600"),
+					resource.TestCheckResourceAttrSet(resourceName, "last_vault_rotation"),
+					testAccLDAPSecretBackendStaticRoleLastRotation(resourceName, &lastRotation, false),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"rotate_now"},
+			},
+			{
+				Config: testAccLDAPSecretBackendStaticRoleConfig(backend, url, bindDN, bindPass, name, username, userDN, 7200, `
+  rotate_now = {
+    at = "1"
+  }
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "rotation_period", "7200"),
+					testAccLDAPSecretBackendStaticRoleLastRotation(resourceName, &lastRotation, true),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLDAPStaticCredentials(t *testing.T) {
+	url, bindDN, bindPass, userDN := getTestLDAPSecretConfig(t)
+	username := strings.SplitN(strings.SplitN(userDN, ",", 2)[0], "=", 2)[1]
+	backend := acctest.RandomWithPrefix("tf-test-ldap")
+	name := acctest.RandomWithPrefix("role")
+	dataSourceName := "data.vault_ldap_static_credentials.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLDAPSecretBackendStaticRoleConfig(backend, url, bindDN, bindPass, name, username, userDN, 3600, "") + `
+data "vault_ldap_static_credentials" "test" {
+  backend = vault_mount.test.path
+  role    = vault_ldap_secret_backend_static_role.test.name
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "username", username),
+					resource.TestCheckResourceAttr(dataSourceName, "dn", userDN),
+					resource.TestCheckResourceAttr(dataSourceName, "rotation_period", "3600"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "password"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "last_vault_rotation"),
+				),
+			},
+		},
+	})
+}
+
+func TestLDAPSecretBackendRoleParsePath(t *testing.T) {
+	for kind, path := range map[string]string{
+		"static-role": "ldap/nested/static-role/svc",
+		"role":        "ldap/nested/role/svc",
+	} {
+		backend, name, err := ldapSecretBackendRoleParsePath(path, kind)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if backend != "ldap/nested" || name != "svc" {
+			t.Errorf("expected backend %q and name %q, got %q and %q", "ldap/nested", "svc", backend, name)
+		}
+	}
+
+	for _, path := range []string{"ldap/static-role/", "static-role/svc", "ldap/role/svc"} {
+		if _, _, err := ldapSecretBackendRoleParsePath(path, "static-role"); err == nil {
+			t.Errorf("expected an error parsing %q", path)
+		}
+	}
+}
+
+// testAccLDAPSecretBackendStaticRoleLastRotation records last_vault_rotation,
+// and checks whether it changed since the previous step.
+func testAccLDAPSecretBackendStaticRoleLastRotation(resourceName string, last *string, changed bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return fmt.Errorf("resource %q not found in state", resourceName)
+		}
+		current := rs.Primary.Attributes["last_vault_rotation"]
+		if changed && current == *last {
+			return fmt.Errorf("expected last_vault_rotation to change from %q", *last)
+		}
+		*last = current
+		return nil
+	}
+}
+
+func testAccLDAPSecretBackendRoleCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_ldap_secret_backend_static_role" && rs.Type != "vault_ldap_secret_backend_dynamic_role" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("role %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccLDAPSecretBackendConfig(backend, url, bindDN, bindPass string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "ldap"
+}
+
+resource "vault_generic_endpoint" "config" {
+  path                 = "${vault_mount.test.path}/config"
+  ignore_absent_fields = true
+  data_json            = jsonencode({
+    url      = %q
+    binddn   = %q
+    bindpass = %q
+    schema   = "openldap"
+  })
+}
+`, backend, url, bindDN, bindPass)
+}
+
+func testAccLDAPSecretBackendStaticRoleConfig(backend, url, bindDN, bindPass, name, username, dn string, rotationPeriod int, extra string) string {
+	return testAccLDAPSecretBackendConfig(backend, url, bindDN, bindPass) + fmt.Sprintf(`
+resource "vault_ldap_secret_backend_static_role" "test" {
+  backend         = vault_mount.test.path
+  name            = "%s"
+  username        = "%s"
+  dn              = "%s"
+  rotation_period = %d
+  depends_on      = [vault_generic_endpoint.config]
+%s
+}
+`, name, username, dn, rotationPeriod, extra)
+}
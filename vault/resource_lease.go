@@ -0,0 +1,147 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func leaseResource() *schema.Resource {
+	return &schema.Resource{
+		Create: leaseResourceCreate,
+		Read:   leaseResourceRead,
+		Update: leaseResourceRead,
+		Delete: leaseResourceDelete,
+
+		Schema: map[string]*schema.Schema{
+			"lease_id": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The ID of the lease to manage.",
+			},
+			"increment": {
+				Type:        schema.TypeInt,
+				Optional:    true,
+				Default:     0,
+				Description: "The requested lease extension in seconds when renewing. Zero means the backend default.",
+			},
+			"renewable": {
+				Type:        schema.TypeBool,
+				Computed:    true,
+				Description: "True if the lease can be renewed.",
+			},
+			"lease_duration": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Remaining lease duration in seconds, as of the last renewal or lookup.",
+			},
+			"last_renewal": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time of the last renewal of the lease.",
+			},
+		},
+	}
+}
+
+func leaseResourceCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	leaseID := d.Get("lease_id").(string)
+
+	log.Printf("[DEBUG] Looking up lease %q", leaseID)
+	secret, err := leaseLookup(client, leaseID)
+	if err != nil {
+		return fmt.Errorf("error looking up lease %q: %s", leaseID, err)
+	}
+	if secret == nil {
+		return fmt.Errorf("lease %q not found or already expired", leaseID)
+	}
+
+	d.SetId(leaseID)
+	return leaseResourceRead(d, meta)
+}
+
+func leaseResourceRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	leaseID := d.Id()
+
+	log.Printf("[DEBUG] Looking up lease %q", leaseID)
+	secret, err := leaseLookup(client, leaseID)
+	if err != nil {
+		return fmt.Errorf("error looking up lease %q: %s", leaseID, err)
+	}
+	if secret == nil {
+		log.Printf("[WARN] Lease %q not found or expired, removing from state", leaseID)
+		d.SetId("")
+		return nil
+	}
+
+	renewable, _ := secret.Data["renewable"].(bool)
+	d.Set("lease_id", leaseID)
+	d.Set("renewable", renewable)
+
+	if !renewable {
+		if v, ok := secret.Data["ttl"]; ok {
+			ttl, err := v.(json.Number).Int64()
+			if err != nil {
+				return fmt.Errorf("unexpected value %q for ttl of lease %q", v, leaseID)
+			}
+			d.Set("lease_duration", ttl)
+		}
+		d.Set("last_renewal", secret.Data["last_renewal"])
+		return nil
+	}
+
+	log.Printf("[DEBUG] Renewing lease %q", leaseID)
+	renewed, err := client.Sys().Renew(leaseID, d.Get("increment").(int))
+	if err != nil {
+		return fmt.Errorf("error renewing lease %q: %s", leaseID, err)
+	}
+	log.Printf("[DEBUG] Renewed lease %q", leaseID)
+
+	d.Set("lease_duration", renewed.LeaseDuration)
+	d.Set("last_renewal", time.Now().UTC().Format(time.RFC3339))
+
+	return nil
+}
+
+func leaseResourceDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	leaseID := d.Id()
+
+	log.Printf("[DEBUG] Revoking lease %q", leaseID)
+	if err := client.Sys().Revoke(leaseID); err != nil && !isInvalidLeaseError(err) {
+		return fmt.Errorf("error revoking lease %q: %s", leaseID, err)
+	}
+	log.Printf("[DEBUG] Revoked lease %q", leaseID)
+
+	return nil
+}
+
+// leaseLookup returns the metadata of the given lease, or nil if the lease
+// does not exist or has already expired.
+func leaseLookup(client *api.Client, leaseID string) (*api.Secret, error) {
+	secret, err := client.Logical().Write("sys/leases/lookup", map[string]interface{}{
+		"lease_id": leaseID,
+	})
+	if err != nil {
+		if isInvalidLeaseError(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return secret, nil
+}
+
+func isInvalidLeaseError(err error) bool {
+	return strings.Contains(err.Error(), "invalid lease") || strings.Contains(err.Error(), "lease not found")
+}
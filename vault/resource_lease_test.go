@@ -0,0 +1,253 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestResourceLease(t *testing.T) {
+	if os.Getenv(resource.TestEnvVar) == "" {
+		t.Skip(fmt.Sprintf("Acceptance tests skipped unless env '%s' set", resource.TestEnvVar))
+	}
+	testAccPreCheck(t)
+
+	client, mount, leaseID := testResourceLeaseCreateLease(t)
+	defer func() {
+		if err := client.Sys().Unmount(mount); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testResourceLeaseCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceLease_config(leaseID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_lease.test", "id", leaseID),
+					resource.TestCheckResourceAttr("vault_lease.test", "lease_id", leaseID),
+					resource.TestCheckResourceAttr("vault_lease.test", "renewable", "false"),
+					resource.TestCheckResourceAttrSet("vault_lease.test", "lease_duration"),
+				),
+			},
+			{
+				// A lease revoked out of band must be removed from state.
+				PreConfig: func() {
+					if err := client.Sys().Revoke(leaseID); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config:             testResourceLease_config(leaseID),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestResourceLease_renewable(t *testing.T) {
+	if os.Getenv(resource.TestEnvVar) == "" {
+		t.Skip(fmt.Sprintf("Acceptance tests skipped unless env '%s' set", resource.TestEnvVar))
+	}
+	connURL := os.Getenv("POSTGRES_URL")
+	if connURL == "" {
+		t.Skip("POSTGRES_URL not set")
+	}
+	testAccPreCheck(t)
+
+	client, mount, leaseID, shortLeaseID, shortExpiry := testResourceLeaseCreateDatabaseLeases(t, connURL)
+	defer func() {
+		if err := client.Sys().Unmount(mount); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	var lastRenewal string
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testResourceLeaseCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceLease_renewableConfig(leaseID, shortLeaseID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_lease.test", "renewable", "true"),
+					resource.TestCheckResourceAttr("vault_lease.test", "increment", "600"),
+					resource.TestCheckResourceAttr("vault_lease.test", "lease_duration", "600"),
+					testResourceLeaseCheckRenewed("vault_lease.test", &lastRenewal),
+				),
+			},
+			{
+				// Refreshing the lease must renew it again. Renewal times
+				// have a resolution of a second, wait for it to pass.
+				PreConfig: func() { time.Sleep(time.Second) },
+				Config:    testResourceLease_renewableConfig(leaseID, shortLeaseID),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_lease.test", "lease_duration", "600"),
+					testResourceLeaseCheckRenewed("vault_lease.test", &lastRenewal),
+				),
+			},
+			{
+				// A lease past its max_ttl can't be renewed any more, and
+				// must be removed from state once expired.
+				PreConfig: func() {
+					time.Sleep(time.Until(shortExpiry))
+				},
+				Config:             testResourceLease_renewableConfig(leaseID, shortLeaseID),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+// testResourceLeaseCheckRenewed checks last_renewal of the lease changed since
+// the previous check, and records it.
+func testResourceLeaseCheckRenewed(name string, lastRenewal *string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource %q not found in state", name)
+		}
+
+		renewal := rs.Primary.Attributes["last_renewal"]
+		if renewal == "" {
+			return fmt.Errorf("expected %q to record its last renewal", name)
+		}
+		if renewal == *lastRenewal {
+			return fmt.Errorf("expected %q to be renewed again, last renewal still %s", name, renewal)
+		}
+		*lastRenewal = renewal
+
+		return nil
+	}
+}
+
+// testResourceLeaseCreateDatabaseLeases generates PostgreSQL credentials from
+// a freshly mounted database backend, and returns their renewable lease IDs.
+// The short lease expires for good at the returned time.
+func testResourceLeaseCreateDatabaseLeases(t *testing.T, connURL string) (*api.Client, string, string, string, time.Time) {
+	client := testAccClient(t)
+
+	mount := acctest.RandomWithPrefix("tf-test-db")
+	if err := client.Sys().Mount(mount, &api.MountInput{Type: "database"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.Logical().Write(mount+"/config/postgres", map[string]interface{}{
+		"plugin_name":    "postgresql-database-plugin",
+		"connection_url": connURL,
+		"allowed_roles":  "*",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	creationStatements := `CREATE ROLE "{{name}}" WITH LOGIN PASSWORD '{{password}}' VALID UNTIL '{{expiration}}';`
+	for role, maxTTL := range map[string]string{"test": "1h", "short": "20s"} {
+		if _, err := client.Logical().Write(mount+"/roles/"+role, map[string]interface{}{
+			"db_name":             "postgres",
+			"creation_statements": creationStatements,
+			"default_ttl":         "60s",
+			"max_ttl":             maxTTL,
+		}); err != nil {
+			t.Fatal(err)
+		}
+	}
+
+	secret, err := client.Logical().Read(mount + "/creds/test")
+	if err != nil {
+		t.Fatal(err)
+	}
+	shortSecret, err := client.Logical().Read(mount + "/creds/short")
+	if err != nil {
+		t.Fatal(err)
+	}
+	shortExpiry := time.Now().Add(time.Duration(shortSecret.LeaseDuration+1) * time.Second)
+
+	return client, mount, secret.LeaseID, shortSecret.LeaseID, shortExpiry
+}
+
+func testResourceLease_renewableConfig(leaseID, shortLeaseID string) string {
+	return fmt.Sprintf(`
+resource "vault_lease" "test" {
+  lease_id  = "%s"
+  increment = 600
+}
+
+resource "vault_lease" "short" {
+  lease_id = "%s"
+}
+`, leaseID, shortLeaseID)
+}
+
+// testResourceLeaseCreateLease issues a certificate from a freshly mounted
+// PKI backend whose role generates leases, and returns the resulting lease ID.
+func testResourceLeaseCreateLease(t *testing.T) (*api.Client, string, string) {
+	client := testAccClient(t)
+
+	mount := acctest.RandomWithPrefix("tf-test-pki")
+	if err := client.Sys().Mount(mount, &api.MountInput{Type: "pki"}); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := client.Logical().Write(mount+"/root/generate/internal", map[string]interface{}{
+		"common_name": "example.com",
+		"ttl":         "1h",
+	}); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Logical().Write(mount+"/roles/test", map[string]interface{}{
+		"allowed_domains":  "example.com",
+		"allow_subdomains": true,
+		"generate_lease":   true,
+		"max_ttl":          "1h",
+	}); err != nil {
+		t.Fatal(err)
+	}
+
+	secret, err := client.Logical().Write(mount+"/issue/test", map[string]interface{}{
+		"common_name": "lease.example.com",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if secret.LeaseID == "" {
+		t.Fatal("expected a lease to be generated")
+	}
+
+	return client, mount, secret.LeaseID
+}
+
+func testResourceLeaseCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_lease" {
+			continue
+		}
+		secret, err := leaseLookup(client, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("lease %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testResourceLease_config(leaseID string) string {
+	return fmt.Sprintf(`
+resource "vault_lease" "test" {
+  lease_id = "%s"
+}
+`, leaseID)
+}
@@ -0,0 +1,351 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/customdiff"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+// managedKeyType describes one kind of managed key: the block that configures
+// it, the type Vault expects in the path, and which of its fields are
+// credentials that Vault never returns.
+type managedKeyType struct {
+	block     string
+	vaultType string
+	fields    []string
+	sensitive []string
+}
+
+var managedKeyTypes = []managedKeyType{
+	{
+		block:     "aws",
+		vaultType: "awskms",
+		fields:    []string{"kms_key", "key_bits", "key_type", "curve", "region", "endpoint"},
+		sensitive: []string{"access_key", "secret_key"},
+	},
+	{
+		block:     "pkcs",
+		vaultType: "pkcs11",
+		fields:    []string{"library", "key_label", "key_id", "slot", "token_label", "mechanism", "key_bits", "curve", "force_rw_session"},
+		sensitive: []string{"pin"},
+	},
+}
+
+var managedKeyBlocks = []string{"aws", "pkcs"}
+
+var managedKeysCommonFields = []string{"allow_generate_key", "allow_replace_key", "allow_store_key", "any_mount"}
+
+func managedKeysResource() *schema.Resource {
+	return &schema.Resource{
+		Create: managedKeysWrite,
+		Update: managedKeysWrite,
+		Read:   managedKeysRead,
+		Delete: managedKeysDelete,
+		Exists: managedKeysExists,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		// Moving a key between blocks changes its type, which Vault can't
+		// update in place.
+		CustomizeDiff: customdiff.All(
+			customdiff.ForceNewIfChange("aws", managedKeysBlockToggled),
+			customdiff.ForceNewIfChange("pkcs", managedKeysBlockToggled),
+		),
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the managed key.",
+				ValidateFunc: validateNoTrailingSlash,
+			},
+			"allow_generate_key": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Allow Vault to generate the key in the backend if it doesn't exist.",
+			},
+			"allow_replace_key": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Allow Vault to replace a key that already exists in the backend.",
+			},
+			"allow_store_key": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Allow Vault to store a key in the backend.",
+			},
+			"any_mount": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Allow any mount to use the key, instead of only the mounts it's explicitly allowed on.",
+			},
+			"aws": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				Description:   "Configuration for a key held in AWS KMS.",
+				ConflictsWith: util.CalculateConflictsWith("aws", managedKeyBlocks),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"access_key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+							Description: "The AWS access key to use.",
+						},
+						"secret_key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+							Description: "The AWS secret key to use.",
+						},
+						"kms_key": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "An identifier for the key in AWS KMS.",
+						},
+						"key_bits": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The size in bits of an RSA key, or the curve size of an ECDSA key.",
+						},
+						"key_type": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The type of key to use. One of \"RSA\" or \"ECDSA\".",
+							ValidateFunc: validation.StringInSlice([]string{"RSA", "ECDSA"}, false),
+						},
+						"curve": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The curve of an ECDSA key.",
+						},
+						"region": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Computed:    true,
+							Description: "The AWS region the key lives in.",
+						},
+						"endpoint": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Override for the AWS KMS endpoint.",
+						},
+					},
+				},
+			},
+			"pkcs": {
+				Type:          schema.TypeList,
+				Optional:      true,
+				MaxItems:      1,
+				Description:   "Configuration for a key held in a PKCS#11 HSM.",
+				ConflictsWith: util.CalculateConflictsWith("pkcs", managedKeyBlocks),
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"library": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The name of the kms_library stanza in Vault's configuration for the PKCS#11 library.",
+						},
+						"key_label": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The label of the key in the HSM.",
+						},
+						"key_id": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The ID of the key in the HSM.",
+						},
+						"mechanism": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The PKCS#11 mechanism to use, e.g. \"0x0001\" for CKM_RSA_PKCS.",
+						},
+						"pin": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Sensitive:   true,
+							Description: "The PIN used to log in to the HSM.",
+						},
+						"slot": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The slot number of the token. Conflicts with token_label.",
+						},
+						"token_label": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The label of the token. Conflicts with slot.",
+						},
+						"key_bits": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The size in bits of an RSA key. Required when generating RSA keys.",
+						},
+						"curve": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The curve of an ECDSA key. Required when generating ECDSA keys.",
+						},
+						"force_rw_session": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "Force all operations to open a read-write session to the HSM.",
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func managedKeysBlockToggled(old, new, meta interface{}) bool {
+	return len(old.([]interface{})) != len(new.([]interface{}))
+}
+
+func managedKeysWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	keyType, config, err := managedKeysConfig(d)
+	if err != nil {
+		return err
+	}
+
+	path := managedKeysPath(keyType.vaultType, d.Get("name").(string))
+
+	data := map[string]interface{}{}
+	for _, k := range managedKeysCommonFields {
+		data[k] = d.Get(k)
+	}
+	for _, k := range append(keyType.fields, keyType.sensitive...) {
+		if v, ok := config[k]; ok && v != "" {
+			data[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Writing managed key %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing managed key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote managed key %q", path)
+
+	d.SetId(path)
+
+	return managedKeysRead(d, meta)
+}
+
+func managedKeysRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	keyType, name, err := managedKeysParsePath(path)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Reading managed key %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading managed key %q: %s", path, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] Managed key %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+	log.Printf("[DEBUG] Read managed key %q", path)
+
+	d.Set("name", name)
+	for _, k := range managedKeysCommonFields {
+		if v, ok := resp.Data[k]; ok {
+			d.Set(k, v)
+		}
+	}
+
+	// Vault doesn't return the credentials, so they're kept as they are in
+	// state and only cause a diff when their configured values change.
+	config := map[string]interface{}{}
+	if v, ok := d.GetOk(keyType.block); ok {
+		for k, v := range v.([]interface{})[0].(map[string]interface{}) {
+			config[k] = v
+		}
+	}
+	for _, k := range keyType.fields {
+		if v, ok := resp.Data[k]; ok && v != nil {
+			config[k] = fmt.Sprintf("%v", v)
+		}
+	}
+	if err := d.Set(keyType.block, []interface{}{config}); err != nil {
+		return fmt.Errorf("error setting %q for managed key %q: %s", keyType.block, path, err)
+	}
+
+	return nil
+}
+
+func managedKeysDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting managed key %q", path)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return fmt.Errorf("error deleting managed key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted managed key %q", path)
+
+	return nil
+}
+
+func managedKeysExists(d *schema.ResourceData, meta interface{}) (bool, error) {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Checking if managed key %q exists", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return true, fmt.Errorf("error checking if managed key %q exists: %s", path, err)
+	}
+
+	return resp != nil, nil
+}
+
+// managedKeysConfig returns the type of the configured key and the contents
+// of its block.
+func managedKeysConfig(d *schema.ResourceData) (*managedKeyType, map[string]interface{}, error) {
+	for i, t := range managedKeyTypes {
+		if v, ok := d.GetOk(t.block); ok {
+			return &managedKeyTypes[i], v.([]interface{})[0].(map[string]interface{}), nil
+		}
+	}
+
+	return nil, nil, fmt.Errorf("one of %s must be configured", strings.Join(managedKeyBlocks, ", "))
+}
+
+func managedKeysPath(keyType, name string) string {
+	return "sys/managed-keys/" + keyType + "/" + strings.Trim(name, "/")
+}
+
+func managedKeysParsePath(path string) (*managedKeyType, string, error) {
+	parts := strings.SplitN(strings.TrimPrefix(path, "sys/managed-keys/"), "/", 2)
+	if !strings.HasPrefix(path, "sys/managed-keys/") || len(parts) != 2 || parts[1] == "" {
+		return nil, "", fmt.Errorf("expected a path of the form sys/managed-keys/<type>/<name>, got %q", path)
+	}
+
+	for i, t := range managedKeyTypes {
+		if t.vaultType == parts[0] {
+			return &managedKeyTypes[i], parts[1], nil
+		}
+	}
+
+	return nil, "", fmt.Errorf("unsupported managed key type %q in %q", parts[0], path)
+}
@@ -0,0 +1,129 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestManagedKeys_aws(t *testing.T) {
+	if os.Getenv("TF_ACC_ENTERPRISE") == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+	accessKey, secretKey := getTestAWSCreds(t)
+	region := getTestAWSRegion(t)
+
+	name := acctest.RandomWithPrefix("aws-key")
+	resourceName := "vault_managed_keys.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testManagedKeysCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testManagedKeysConfig_aws(name, accessKey, secretKey, region, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "sys/managed-keys/awskms/"+name),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "allow_generate_key", "false"),
+					resource.TestCheckResourceAttr(resourceName, "aws.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "aws.0.kms_key", "alias/"+name),
+					resource.TestCheckResourceAttr(resourceName, "aws.0.key_bits", "2048"),
+					resource.TestCheckResourceAttr(resourceName, "aws.0.key_type", "RSA"),
+					resource.TestCheckResourceAttr(resourceName, "aws.0.region", region),
+				),
+			},
+			{
+				// Vault doesn't return the credentials, which must not diff.
+				Config:   testManagedKeysConfig_aws(name, accessKey, secretKey, region, false),
+				PlanOnly: true,
+			},
+			{
+				Config: testManagedKeysConfig_aws(name, accessKey, secretKey, region, true),
+				Check:  resource.TestCheckResourceAttr(resourceName, "allow_generate_key", "true"),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"aws.0.access_key", "aws.0.secret_key"},
+			},
+		},
+	})
+}
+
+func TestManagedKeysParsePath(t *testing.T) {
+	tests := []struct {
+		path      string
+		block     string
+		name      string
+		expectErr bool
+	}{
+		{path: "sys/managed-keys/awskms/foo", block: "aws", name: "foo"},
+		{path: "sys/managed-keys/pkcs11/foo", block: "pkcs", name: "foo"},
+		{path: "sys/managed-keys/gcpckms/foo", expectErr: true},
+		{path: "sys/managed-keys/awskms", expectErr: true},
+		{path: "sys/managed-keys/awskms/", expectErr: true},
+		{path: "foo", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		keyType, name, err := managedKeysParsePath(tt.path)
+		if tt.expectErr {
+			if err == nil {
+				t.Errorf("expected an error parsing %q", tt.path)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unexpected error parsing %q: %s", tt.path, err)
+			continue
+		}
+		if keyType.block != tt.block || name != tt.name {
+			t.Errorf("parsing %q: expected %s/%s, got %s/%s", tt.path, tt.block, tt.name, keyType.block, name)
+		}
+	}
+}
+
+func testManagedKeysCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_managed_keys" {
+			continue
+		}
+		resp, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if resp != nil {
+			return fmt.Errorf("managed key %q still exists", rs.Primary.ID)
+		}
+	}
+
+	return nil
+}
+
+func testManagedKeysConfig_aws(name, accessKey, secretKey, region string, allowGenerate bool) string {
+	return fmt.Sprintf(`
+resource "vault_managed_keys" "test" {
+  name               = "%[1]s"
+  allow_generate_key = %[5]t
+
+  aws {
+    access_key = "%[2]s"
+    secret_key = "%[3]s"
+    kms_key    = "alias/%[1]s"
+    key_bits   = "2048"
+    key_type   = "RSA"
+    region     = "%[4]s"
+  }
+}
+`, name, accessKey, secretKey, region, allowGenerate)
+}
@@ -102,16 +102,22 @@ func mfaDuoDelete(d *schema.ResourceData, meta interface{}) error {
 func mfaDuoRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 
-	name := d.Get("name").(string)
+	name := d.Id()
 
 	resp, err := client.Logical().Read(mfaDuoPath(name))
 
 	if err != nil {
 		return fmt.Errorf("error reading from Vault: %s", err)
 	}
+	if resp == nil {
+		log.Printf("[WARN] MFA Duo config %q not found, removing from state", mfaDuoPath(name))
+		d.SetId("")
+		return nil
+	}
 
 	log.Printf("[DEBUG] Read MFA Duo config %q", mfaDuoPath(name))
 
+	d.Set("name", name)
 	d.Set("mount_accessor", resp.Data["mount_accessor"])
 	d.Set("username_format", resp.Data["username_format"])
 	d.Set("api_hostname", resp.Data["api_hostname"])
@@ -120,10 +126,9 @@ func mfaDuoRead(d *schema.ResourceData, meta interface{}) error {
 	// when vault responds, it's pushinfo :(
 	d.Set("push_info", resp.Data["pushinfo"])
 
-	// secret_key and integration_key, can't read out from the api
-	// So... if it drifts, it drift.
-
-	d.SetId(name)
+	// secret_key and integration_key can't be read out from the API, so
+	// they're left as they are in state. That way they only cause a diff
+	// when their configured values change. If they drift, they drift.
 
 	return nil
 }
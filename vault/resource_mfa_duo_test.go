@@ -18,13 +18,14 @@ func TestMFADuoBasic(t *testing.T) {
 	}
 
 	mfaDuoPath := acctest.RandomWithPrefix("mfa-duo")
+	userPassPath := acctest.RandomWithPrefix("userpass")
 
 	resource.Test(t, resource.TestCase{
 		PreCheck:  func() { testAccPreCheck(t) },
 		Providers: testProviders,
 		Steps: []resource.TestStep{
 			{
-				Config: testMFADuoConfig(mfaDuoPath),
+				Config: testMFADuoConfig(mfaDuoPath, userPassPath, "8C7THtrIigh2rPZQMbguugt8IUftWhMRCOBzbuyz"),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("vault_mfa_duo.test", "name", mfaDuoPath),
 					resource.TestCheckResourceAttr("vault_mfa_duo.test", "secret_key", "8C7THtrIigh2rPZQMbguugt8IUftWhMRCOBzbuyz"),
@@ -34,14 +35,26 @@ func TestMFADuoBasic(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_mfa_duo.test", "push_info", "from=loginortal&domain=example.com"),
 				),
 			},
+			{
+				// Vault doesn't return the secret fields, which must not diff.
+				Config:   testMFADuoConfig(mfaDuoPath, userPassPath, "8C7THtrIigh2rPZQMbguugt8IUftWhMRCOBzbuyz"),
+				PlanOnly: true,
+			},
+			{
+				Config: testMFADuoConfig(mfaDuoPath, userPassPath, "9D8UIusJjhi3sQAQNchvvhu9JVguXiNSDPCacvza"),
+				Check:  resource.TestCheckResourceAttr("vault_mfa_duo.test", "secret_key", "9D8UIusJjhi3sQAQNchvvhu9JVguXiNSDPCacvza"),
+			},
+			{
+				ResourceName:            "vault_mfa_duo.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret_key", "integration_key"},
+			},
 		},
 	})
 }
 
-func testMFADuoConfig(path string) string {
-
-	userPassPath := acctest.RandomWithPrefix("userpass")
-
+func testMFADuoConfig(path, userPassPath, secretKey string) string {
 	return fmt.Sprintf(`
 resource "vault_auth_backend" "userpass" {
   type = "userpass"
@@ -51,12 +64,12 @@ resource "vault_auth_backend" "userpass" {
 resource "vault_mfa_duo" "test" {
   name                  = %q
   mount_accessor        = "${vault_auth_backend.userpass.accessor}"
-  secret_key            = "8C7THtrIigh2rPZQMbguugt8IUftWhMRCOBzbuyz"
+  secret_key            = %q
   integration_key       = "BIACEUEAXI20BNWTEYXT"
   api_hostname          = "api-2b5c39f5.duosecurity.com"
   username_format       = "user@example.com"
   push_info             = "from=loginortal&domain=example.com"
 }
-`, userPassPath, path)
+`, userPassPath, path, secretKey)
 
 }
@@ -0,0 +1,152 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+// mfaLoginEnforcementTargets are the fields that select which logins the
+// enforcement applies to. At least one of them has to be set.
+var mfaLoginEnforcementTargets = []string{
+	"auth_method_accessors",
+	"auth_method_types",
+	"identity_group_ids",
+	"identity_entity_ids",
+}
+
+func mfaLoginEnforcementResource() *schema.Resource {
+	s := map[string]*schema.Schema{
+		"name": {
+			Type:         schema.TypeString,
+			Required:     true,
+			ForceNew:     true,
+			Description:  "Name of the login enforcement.",
+			ValidateFunc: validateNoTrailingSlash,
+		},
+		"mfa_method_ids": {
+			Type:        schema.TypeSet,
+			Required:    true,
+			MinItems:    1,
+			Description: "IDs of the MFA methods that logins have to pass.",
+			Elem: &schema.Schema{
+				Type:         schema.TypeString,
+				ValidateFunc: validation.StringIsNotEmpty,
+			},
+		},
+		"uuid": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The unique ID generated by Vault for the login enforcement.",
+		},
+		"namespace_id": {
+			Type:        schema.TypeString,
+			Computed:    true,
+			Description: "The ID of the namespace the login enforcement belongs to.",
+		},
+	}
+	targetDescriptions := map[string]string{
+		"auth_method_accessors": "Accessors of the auth mounts to enforce MFA on.",
+		"auth_method_types":     "Types of auth methods to enforce MFA on, e.g. \"userpass\".",
+		"identity_group_ids":    "IDs of the identity groups whose members MFA is enforced on.",
+		"identity_entity_ids":   "IDs of the identity entities MFA is enforced on.",
+	}
+	for _, k := range mfaLoginEnforcementTargets {
+		s[k] = &schema.Schema{
+			Type:         schema.TypeSet,
+			Optional:     true,
+			Description:  targetDescriptions[k],
+			AtLeastOneOf: mfaLoginEnforcementTargets,
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		}
+	}
+
+	return &schema.Resource{
+		Create: mfaLoginEnforcementWrite,
+		Update: mfaLoginEnforcementWrite,
+		Read:   mfaLoginEnforcementRead,
+		Delete: mfaLoginEnforcementDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: s,
+	}
+}
+
+func mfaLoginEnforcementWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Get("name").(string)
+	path := mfaLoginEnforcementPath(name)
+
+	data := map[string]interface{}{
+		"mfa_method_ids": d.Get("mfa_method_ids").(*schema.Set).List(),
+	}
+	for _, k := range mfaLoginEnforcementTargets {
+		data[k] = d.Get(k).(*schema.Set).List()
+	}
+
+	log.Printf("[DEBUG] Writing MFA login enforcement %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing MFA login enforcement %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote MFA login enforcement %q", path)
+
+	d.SetId(name)
+
+	return mfaLoginEnforcementRead(d, meta)
+}
+
+func mfaLoginEnforcementRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := mfaLoginEnforcementPath(d.Id())
+
+	log.Printf("[DEBUG] Reading MFA login enforcement %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading MFA login enforcement %q: %s", path, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] MFA login enforcement %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+	log.Printf("[DEBUG] Read MFA login enforcement %q", path)
+
+	d.Set("name", d.Id())
+	d.Set("uuid", resp.Data["id"])
+	d.Set("namespace_id", resp.Data["namespace_id"])
+	for _, k := range append([]string{"mfa_method_ids"}, mfaLoginEnforcementTargets...) {
+		if err := d.Set(k, resp.Data[k]); err != nil {
+			return fmt.Errorf("error setting %s for MFA login enforcement %q: %s", k, path, err)
+		}
+	}
+
+	return nil
+}
+
+func mfaLoginEnforcementDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := mfaLoginEnforcementPath(d.Id())
+
+	log.Printf("[DEBUG] Deleting MFA login enforcement %q", path)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return fmt.Errorf("error deleting MFA login enforcement %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted MFA login enforcement %q", path)
+
+	return nil
+}
+
+func mfaLoginEnforcementPath(name string) string {
+	return "identity/mfa/login-enforcement/" + strings.Trim(name, "/")
+}
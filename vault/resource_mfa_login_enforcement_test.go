@@ -0,0 +1,67 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestMFALoginEnforcement(t *testing.T) {
+	name := acctest.RandomWithPrefix("enforcement")
+	resourceName := "vault_mfa_login_enforcement.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config:      testMFALoginEnforcementConfig(name, ""),
+				ExpectError: regexp.MustCompile("one of `auth_method_accessors,auth_method_types,identity_entity_ids,identity_group_ids` must be specified"),
+			},
+			{
+				Config: testMFALoginEnforcementConfig(name, `auth_method_types = ["userpass"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttrSet(resourceName, "uuid"),
+					resource.TestCheckResourceAttr(resourceName, "mfa_method_ids.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auth_method_types.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "auth_method_accessors.#", "0"),
+				),
+			},
+			{
+				Config: testMFALoginEnforcementConfig(name, `auth_method_accessors = [vault_auth_backend.test.accessor]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "auth_method_types.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "auth_method_accessors.#", "1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testMFALoginEnforcementConfig(name, targets string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "test" {
+  type = "userpass"
+  path = "%[1]s"
+}
+
+resource "vault_identity_mfa_totp" "test" {
+  issuer = "%[1]s"
+}
+
+resource "vault_mfa_login_enforcement" "test" {
+  name           = "%[1]s"
+  mfa_method_ids = [vault_identity_mfa_totp.test.method_id]
+  %[2]s
+}
+`, name, targets)
+}
@@ -4,11 +4,18 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
 	"github.com/hashicorp/vault/api"
 )
 
+// mountDisableRetryTimeout is how long we'll keep retrying to disable a
+// mount that Vault can't remove yet, e.g. while leases under it are revoked.
+const mountDisableRetryTimeout = 2 * time.Minute
+
 func MountResource() *schema.Resource {
 	return &schema.Resource{
 		Create: mountWrite,
@@ -20,6 +27,7 @@ func MountResource() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"namespace": namespaceSchema(),
 			"path": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -100,12 +108,25 @@ func MountResource() *schema.Resource {
 				ForceNew:    true,
 				Description: "Enable the secrets engine to access Vault's external entropy source",
 			},
+
+			"force_delete": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Force-revoke all leases under the mount before disabling it on destroy. Lease revocation errors from the backend are ignored.",
+			},
 		},
 	}
 }
 
 func mountWrite(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*api.Client)
+	client, err := namespaceClient(d, meta)
+	if err != nil {
+		return err
+	}
+
+	// The KV version of the mounts cached for secrets may change.
+	defer kvMounts.reset()
 
 	info := &api.MountInput{
 		Type:        d.Get("type").(string),
@@ -134,7 +155,12 @@ func mountWrite(d *schema.ResourceData, meta interface{}) error {
 }
 
 func mountUpdate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*api.Client)
+	client, err := namespaceClient(d, meta)
+	if err != nil {
+		return err
+	}
+
+	defer kvMounts.reset()
 
 	config := api.MountConfigInput{
 		DefaultLeaseTTL: fmt.Sprintf("%ds", d.Get("default_lease_ttl_seconds")),
@@ -173,21 +199,71 @@ func mountUpdate(d *schema.ResourceData, meta interface{}) error {
 }
 
 func mountDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*api.Client)
+	client, err := namespaceClient(d, meta)
+	if err != nil {
+		return err
+	}
+
+	defer kvMounts.reset()
 
 	path := d.Id()
 
 	log.Printf("[DEBUG] Unmounting %s from Vault", path)
 
-	if err := client.Sys().Unmount(path); err != nil {
+	if err := disableMountWithRetry(client, path, path, d.Get("force_delete").(bool), client.Sys().Unmount); err != nil {
 		return fmt.Errorf("error deleting from Vault: %s", err)
 	}
 
 	return nil
 }
 
+// disableMountWithRetry calls disable for the mount at path, retrying with
+// backoff while it fails transiently, see isRetryableDisableMountError. When
+// forceDelete is set, all leases under leasePrefix are force-revoked first so
+// lingering leases whose backend can no longer revoke them don't block the
+// disable.
+func disableMountWithRetry(client *api.Client, path, leasePrefix string, forceDelete bool, disable func(string) error) error {
+	if forceDelete {
+		log.Printf("[DEBUG] Force-revoking leases under %q", leasePrefix)
+		if err := client.Sys().RevokeForce(leasePrefix); err != nil {
+			return fmt.Errorf("error force-revoking leases under %q: %s", leasePrefix, err)
+		}
+	}
+
+	return resource.Retry(mountDisableRetryTimeout, func() *resource.RetryError {
+		err := disable(path)
+		if err == nil {
+			return nil
+		}
+		if !isRetryableDisableMountError(err) {
+			return resource.NonRetryableError(err)
+		}
+		log.Printf("[DEBUG] Disabling %q failed, retrying: %s", path, err)
+		return resource.RetryableError(err)
+	})
+}
+
+// isRetryableDisableMountError reports whether disabling a mount failed while
+// its leases were being revoked or the mount was in use, which may succeed
+// when retried. Other errors, e.g. a mount that doesn't exist, are permanent.
+func isRetryableDisableMountError(err error) bool {
+	if util.IsPermissionDenied(err) {
+		return false
+	}
+	msg := err.Error()
+	for _, s := range []string{"failed to revoke", "error revoking", "in use", "Code: 500", "Code: 502", "Code: 503"} {
+		if strings.Contains(msg, s) {
+			return true
+		}
+	}
+	return false
+}
+
 func mountRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*api.Client)
+	client, err := namespaceClient(d, meta)
+	if err != nil {
+		return err
+	}
 
 	path := d.Id()
 
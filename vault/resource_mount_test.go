@@ -1,6 +1,7 @@
 package vault
 
 import (
+	"errors"
 	"fmt"
 	"testing"
 
@@ -170,6 +171,97 @@ func TestResourceMount_ExternalEntropyAccess(t *testing.T) {
 	})
 }
 
+func TestResourceMount_forceDelete(t *testing.T) {
+	path := acctest.RandomWithPrefix("pki")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		CheckDestroy: resource.ComposeTestCheckFunc(
+			testResourceMount_checkDestroyed(path),
+			testAccCheckLeasesRevoked(path+"/issue/"),
+		),
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceMount_forceDeleteConfig(path),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_mount.test", "force_delete", "true"),
+					func(s *terraform.State) error {
+						// Issue a certificate so a lease exists under the mount when it's destroyed.
+						client := testProvider.Meta().(*api.Client)
+						secret, err := client.Logical().Write(path+"/issue/test", map[string]interface{}{
+							"common_name": "lease.example.com",
+						})
+						if err != nil {
+							return err
+						}
+						if secret.LeaseID == "" {
+							return fmt.Errorf("expected a lease to be generated")
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func TestIsRetryableDisableMountError(t *testing.T) {
+	tests := map[string]bool{
+		"Error making API request.\n\nCode: 400. Errors:\n\n* failed to revoke \"pki/issue/test/abc\" (1 / 1): failed to revoke entry": true,
+		"Error making API request.\n\nCode: 500. Errors:\n\n* internal error":                                                          true,
+		"Error making API request.\n\nCode: 400. Errors:\n\n* no matching mount":                                                       false,
+		"Error making API request.\n\nCode: 403. Errors:\n\n* permission denied":                                                       false,
+		"Error making API request.\n\nCode: 400. Errors:\n\n* namespace not found":                                                     false,
+	}
+
+	for msg, expected := range tests {
+		if actual := isRetryableDisableMountError(errors.New(msg)); actual != expected {
+			t.Errorf("expected %t for %q, got %t", expected, msg, actual)
+		}
+	}
+}
+
+func testResourceMount_checkDestroyed(path string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testProvider.Meta().(*api.Client)
+		mounts, err := client.Sys().ListMounts()
+		if err != nil {
+			return err
+		}
+		if _, ok := mounts[path+"/"]; ok {
+			return fmt.Errorf("mount %q still exists", path)
+		}
+		return nil
+	}
+}
+
+func testResourceMount_forceDeleteConfig(path string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path         = "%s"
+  type         = "pki"
+  force_delete = true
+}
+
+resource "vault_pki_secret_backend_root_cert" "test" {
+  backend     = vault_mount.test.path
+  type        = "internal"
+  common_name = "example.com"
+  ttl         = "3600"
+}
+
+resource "vault_pki_secret_backend_role" "test" {
+  depends_on       = [vault_pki_secret_backend_root_cert.test]
+  backend          = vault_mount.test.path
+  name             = "test"
+  allowed_domains  = ["example.com"]
+  allow_subdomains = true
+  generate_lease   = true
+  max_ttl          = "3600"
+}
+`, path)
+}
+
 func testResourceMount_initialConfig(cfg mountConfig) string {
 	return fmt.Sprintf(`
 resource "vault_mount" "test" {
@@ -3,6 +3,7 @@ package vault
 import (
 	"fmt"
 	"log"
+	"net/http"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -12,7 +13,7 @@ import (
 func namespaceResource() *schema.Resource {
 	return &schema.Resource{
 		Create: namespaceWrite,
-		Update: namespaceWrite,
+		Update: namespaceUpdate,
 		Delete: namespaceDelete,
 		Read:   namespaceRead,
 		Importer: &schema.ResourceImporter{
@@ -32,6 +33,15 @@ func namespaceResource() *schema.Resource {
 				Computed:    true,
 				Description: "ID of the namepsace.",
 			},
+
+			"custom_metadata": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Custom metadata describing the namespace. Requires Vault 1.12+.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -41,8 +51,15 @@ func namespaceWrite(d *schema.ResourceData, meta interface{}) error {
 
 	path := d.Get("path").(string)
 
+	var data map[string]interface{}
+	if v, ok := d.GetOk("custom_metadata"); ok {
+		data = map[string]interface{}{
+			"custom_metadata": v,
+		}
+	}
+
 	log.Printf("[DEBUG] Creating namespace %s in Vault", path)
-	_, err := client.Logical().Write("sys/namespaces/"+path, nil)
+	_, err := client.Logical().Write("sys/namespaces/"+path, data)
 
 	if err != nil {
 		return fmt.Errorf("error writing to Vault: %s", err)
@@ -51,6 +68,54 @@ func namespaceWrite(d *schema.ResourceData, meta interface{}) error {
 	return namespaceRead(d, meta)
 }
 
+func namespaceUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChange("path") {
+		return namespaceWrite(d, meta)
+	}
+
+	if !d.HasChange("custom_metadata") {
+		return namespaceRead(d, meta)
+	}
+
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	// Vault only allows updating the metadata of an existing namespace
+	// through a JSON merge patch, where removed keys are set to null.
+	o, n := d.GetChange("custom_metadata")
+	metadata := map[string]interface{}{}
+	for k := range o.(map[string]interface{}) {
+		metadata[k] = nil
+	}
+	for k, v := range n.(map[string]interface{}) {
+		metadata[k] = v
+	}
+
+	log.Printf("[DEBUG] Updating custom metadata of namespace %s in Vault", path)
+	r := client.NewRequest(http.MethodPatch, "/v1/sys/namespaces/"+path)
+	r.Headers = r.Headers.Clone()
+	if r.Headers == nil {
+		r.Headers = http.Header{}
+	}
+	r.Headers.Set("Content-Type", "application/merge-patch+json")
+	if err := r.SetJSONBody(map[string]interface{}{
+		"custom_metadata": metadata,
+	}); err != nil {
+		return err
+	}
+
+	resp, err := client.RawRequest(r)
+	if resp != nil {
+		defer resp.Body.Close()
+	}
+	if err != nil {
+		return fmt.Errorf("error updating namespace %s in Vault: %s", path, err)
+	}
+
+	return namespaceRead(d, meta)
+}
+
 func namespaceDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 
@@ -88,6 +153,8 @@ func namespaceRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.SetId(resp.Data["path"].(string))
 	d.Set("namespace_id", resp.Data["id"])
+	// custom_metadata is not returned by Vault versions prior to 1.12
+	d.Set("custom_metadata", resp.Data["custom_metadata"])
 
 	noTrailingSlashPath := strings.TrimSuffix(path, "/")
 	d.Set("path", noTrailingSlashPath)
@@ -45,6 +45,43 @@ func TestNamespace_basic(t *testing.T) {
 	})
 }
 
+func TestNamespace_customMetadata(t *testing.T) {
+	isEnterprise := os.Getenv("TF_ACC_ENTERPRISE")
+	if isEnterprise == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+
+	namespacePath := acctest.RandomWithPrefix("test-namespace")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testNamespaceDestroy(namespacePath),
+		Steps: []resource.TestStep{
+			{
+				Config: testNamespaceConfig_customMetadata(namespacePath, `
+    owner       = "team-a"
+    cost_center = "1234"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_namespace.test", "custom_metadata.%", "2"),
+					resource.TestCheckResourceAttr("vault_namespace.test", "custom_metadata.owner", "team-a"),
+					resource.TestCheckResourceAttr("vault_namespace.test", "custom_metadata.cost_center", "1234"),
+				),
+			},
+			{
+				Config: testNamespaceConfig_customMetadata(namespacePath, `
+    owner = "team-b"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_namespace.test", "custom_metadata.%", "1"),
+					resource.TestCheckResourceAttr("vault_namespace.test", "custom_metadata.owner", "team-b"),
+				),
+			},
+		},
+	})
+}
+
 func testNamespaceCheckAttrs() resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		resourceState := s.Modules[0].Resources["vault_namespace.test"]
@@ -86,6 +123,18 @@ resource "vault_namespace" "test" {
 
 }
 
+func testNamespaceConfig_customMetadata(path, metadata string) string {
+	return fmt.Sprintf(`
+resource "vault_namespace" "test" {
+  path = %q
+
+  custom_metadata = {
+%s
+  }
+}
+`, path, metadata)
+}
+
 func testNestedNamespaceConfig(parentPath, childPath string) string {
 	return fmt.Sprintf(`
 provider "vault" {
@@ -30,6 +30,13 @@ func TestAccNomadSecretBackendRoleClientBasic(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_nomad_secret_role.test", "type", "client"),
 				),
 			},
+			{
+				Config: testNomadSecretBackendRoleClientConfig(backend, address, token, "bob", "readonly", false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_nomad_secret_role.test", "global", "false"),
+					resource.TestCheckResourceAttr("vault_nomad_secret_role.test", "type", "client"),
+				),
+			},
 		},
 	})
 }
@@ -17,6 +17,9 @@ func oktaAuthBackendUserResource() *schema.Resource {
 		Read:   oktaAuthBackendUserRead,
 		Update: oktaAuthBackendUserWrite,
 		Delete: oktaAuthBackendUserDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
 
 		Schema: map[string]*schema.Schema{
 			"path": {
@@ -105,16 +108,23 @@ func oktaAuthBackendUserWrite(d *schema.ResourceData, meta interface{}) error {
 		return fmt.Errorf("unable to update user %s in Vault: %s", username, err)
 	}
 
-	d.SetId(fmt.Sprintf("%s/%s", path, username))
+	d.SetId(oktaAuthBackendUserID(path, username))
 
 	return oktaAuthBackendUserRead(d, meta)
 }
 
 func oktaAuthBackendUserRead(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
+	id := d.Id()
 
-	path := d.Get("path").(string)
-	username := d.Get("username").(string)
+	path, err := oktaAuthBackendUserPathFromID(id)
+	if err != nil {
+		return fmt.Errorf("invalid id %q for Okta auth backend user: %s", id, err)
+	}
+	username, err := oktaAuthBackendUserNameFromID(id)
+	if err != nil {
+		return fmt.Errorf("invalid id %q for Okta auth backend user: %s", id, err)
+	}
 
 	log.Printf("[DEBUG] Reading user %s from Okta auth backend %s", username, path)
 
@@ -137,6 +147,8 @@ func oktaAuthBackendUserRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("groups", user.Groups)
 	d.Set("policies", user.Policies)
+	d.Set("username", username)
+	d.Set("path", path)
 
 	return nil
 }
@@ -150,10 +162,32 @@ func oktaAuthBackendUserDelete(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Deleting user %s from Okta auth backend %s", username, path)
 
 	if err := deleteOktaUser(client, path, username); err != nil {
-		return fmt.Errorf("unable to delete user %s from Vault: %s", username, path)
+		return fmt.Errorf("unable to delete user %s from Vault: %s", username, err)
 	}
 
 	d.SetId("")
 
 	return nil
 }
+
+func oktaAuthBackendUserID(path, username string) string {
+	return strings.Join([]string{path, username}, "/")
+}
+
+// The backend path may itself contain slashes, so the username is everything
+// after the last one.
+func oktaAuthBackendUserPathFromID(id string) (string, error) {
+	i := strings.LastIndex(id, "/")
+	if i <= 0 || i == len(id)-1 {
+		return "", fmt.Errorf("Expected 2 parts in ID '%s'", id)
+	}
+	return id[:i], nil
+}
+
+func oktaAuthBackendUserNameFromID(id string) (string, error) {
+	i := strings.LastIndex(id, "/")
+	if i <= 0 || i == len(id)-1 {
+		return "", fmt.Errorf("Expected 2 parts in ID '%s'", id)
+	}
+	return id[i+1:], nil
+}
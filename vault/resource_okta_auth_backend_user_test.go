@@ -27,6 +27,18 @@ func TestAccOktaAuthBackendUser(t *testing.T) {
 					testAccOktaAuthBackend_UsersCheck(path, "user_test", []string{"one", "two"}, []string{"three"}),
 				),
 			},
+			{
+				Config: testAccOktaAuthUserConfigUpdated(path, organization),
+				Check: resource.ComposeTestCheckFunc(
+					testAccOktaAuthBackendUser_InitialCheck,
+					testAccOktaAuthBackend_UsersCheck(path, "user_test", []string{"two"}, []string{"three", "four"}),
+				),
+			},
+			{
+				ResourceName:      "vault_okta_auth_backend_user.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
 		},
 	})
 }
@@ -47,6 +59,22 @@ resource "vault_okta_auth_backend_user" "test" {
 `, path, organization)
 }
 
+func testAccOktaAuthUserConfigUpdated(path string, organization string) string {
+	return fmt.Sprintf(`
+resource "vault_okta_auth_backend" "test" {
+    path = "%s"
+    organization = "%s"
+}
+
+resource "vault_okta_auth_backend_user" "test" {
+    path = "${vault_okta_auth_backend.test.path}"
+    username = "user_test"
+    groups = ["two"]
+    policies = ["three", "four"]
+}
+`, path, organization)
+}
+
 func testAccOktaAuthBackendUser_InitialCheck(s *terraform.State) error {
 	resourceState := s.Modules[0].Resources["vault_okta_auth_backend_user.test"]
 	if resourceState == nil {
@@ -76,3 +104,32 @@ func testAccOktaAuthBackendUser_Destroyed(path, userName string) resource.TestCh
 		return nil
 	}
 }
+
+func TestOktaAuthBackendUserFromID(t *testing.T) {
+	for id, expected := range map[string][2]string{
+		"okta/bob":          {"okta", "bob"},
+		"team/okta/bob":     {"team/okta", "bob"},
+		"team/okta/bob@foo": {"team/okta", "bob@foo"},
+	} {
+		path, err := oktaAuthBackendUserPathFromID(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		username, err := oktaAuthBackendUserNameFromID(id)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if path != expected[0] || username != expected[1] {
+			t.Errorf("expected ID %q to be path %q and username %q, got %q and %q", id, expected[0], expected[1], path, username)
+		}
+	}
+
+	for _, id := range []string{"okta", "okta/", "/bob"} {
+		if _, err := oktaAuthBackendUserPathFromID(id); err == nil {
+			t.Errorf("expected an error for ID %q", id)
+		}
+		if _, err := oktaAuthBackendUserNameFromID(id); err == nil {
+			t.Errorf("expected an error for ID %q", id)
+		}
+	}
+}
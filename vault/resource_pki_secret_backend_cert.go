@@ -75,10 +75,19 @@ func pkiSecretBackendCertResource() *schema.Resource {
 				},
 			},
 			"ttl": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				ForceNew:    false,
-				Description: "Time to live.",
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      false,
+				Description:   "Time to live.",
+				ConflictsWith: []string{"not_after"},
+			},
+			"not_after": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Description:   "Absolute expiry of the certificate as a UTC timestamp in the format YYYY-MM-ddTHH:MM:SSZ.",
+				ValidateFunc:  validateUTCTimestamp,
+				ConflictsWith: []string{"ttl"},
 			},
 			"format": {
 				Type:         schema.TypeString,
@@ -196,6 +205,10 @@ func pkiSecretBackendCertCreate(d *schema.ResourceData, meta interface{}) error
 		"exclude_cn_from_sans": d.Get("exclude_cn_from_sans").(bool),
 	}
 
+	if v, ok := d.GetOk("not_after"); ok {
+		data["not_after"] = v.(string)
+	}
+
 	if len(altNames) > 0 {
 		data["alt_names"] = strings.Join(altNames, ",")
 	}
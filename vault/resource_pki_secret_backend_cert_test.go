@@ -36,6 +36,30 @@ func TestPkiSecretBackendCert_basic(t *testing.T) {
 	})
 }
 
+func TestPkiSecretBackendCert_notAfter(t *testing.T) {
+	rootPath := "pki-root-" + strconv.Itoa(acctest.RandInt())
+	intermediatePath := "pki-intermediate-" + strconv.Itoa(acctest.RandInt())
+	notAfter := time.Now().UTC().Add(30 * time.Minute).Truncate(time.Second)
+
+	config := strings.Replace(testPkiSecretBackendCertConfig_basic(rootPath, intermediatePath),
+		`ttl = "720h"`, fmt.Sprintf(`not_after = "%s"`, notAfter.Format(utcTimestampFormat)), 1)
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testPkiSecretBackendCertDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: config,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_cert.test", "not_after", notAfter.Format(utcTimestampFormat)),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_cert.test", "expiration", strconv.FormatInt(notAfter.Unix(), 10)),
+				),
+			},
+		},
+	})
+}
+
 func testPkiSecretBackendCertDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -0,0 +1,179 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+var pkiSecretBackendConfigACMEFields = []string{
+	"enabled",
+	"allowed_issuers",
+	"allowed_roles",
+	"default_directory_policy",
+	"dns_resolver",
+	"eab_policy",
+}
+
+func pkiSecretBackendConfigACMEResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendConfigACMEWrite,
+		Read:   pkiSecretBackendConfigACMERead,
+		Update: pkiSecretBackendConfigACMEWrite,
+		Delete: pkiSecretBackendConfigACMEDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the PKI secret backend the resource belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"enabled": {
+				Type:        schema.TypeBool,
+				Required:    true,
+				Description: "Whether ACME is enabled on the backend.",
+			},
+			"allowed_issuers": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "The issuers ACME clients may request certificates from. \"*\" allows all of them.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"allowed_roles": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Computed:    true,
+				Description: "The roles ACME clients may use. \"*\" allows all of them.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"default_directory_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The policy for the default ACME directory: \"sign-verbatim\", \"forbid\", \"role:<name>\", \"external-policy\" or \"external-policy:<name>\".",
+				ValidateFunc: validatePKIACMEDirectoryPolicy,
+			},
+			"dns_resolver": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The DNS resolver to use for ACME challenges, as host:port. If not set, the system resolver is used.",
+			},
+			"eab_policy": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "When external account bindings are required: \"not-required\", \"new-account-required\" or \"always-required\".",
+				ValidateFunc: validation.StringInSlice([]string{"not-required", "new-account-required", "always-required"}, false),
+			},
+		},
+	}
+}
+
+func pkiSecretBackendConfigACMEWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := strings.Trim(d.Get("backend").(string), "/")
+	path := pkiSecretBackendConfigACMEPath(backend)
+
+	data := map[string]interface{}{
+		"enabled":      d.Get("enabled").(bool),
+		"dns_resolver": d.Get("dns_resolver").(string),
+	}
+	for _, k := range []string{"allowed_issuers", "allowed_roles", "default_directory_policy", "eab_policy"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+
+	log.Printf("[DEBUG] Writing ACME config on PKI secret backend %q", backend)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing ACME config on PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Wrote ACME config on PKI secret backend %q", backend)
+
+	d.SetId(path)
+	return pkiSecretBackendConfigACMERead(d, meta)
+}
+
+func pkiSecretBackendConfigACMERead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	backend := strings.TrimSuffix(path, "/config/acme")
+
+	log.Printf("[DEBUG] Reading ACME config from PKI secret backend %q", backend)
+	config, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading ACME config on PKI secret backend %q: %s", backend, err)
+	}
+	if config == nil {
+		log.Printf("[WARN] ACME config on PKI secret backend %q not found, removing from state", backend)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	for _, k := range pkiSecretBackendConfigACMEFields {
+		if err := d.Set(k, config.Data[k]); err != nil {
+			return fmt.Errorf("error setting %s for ACME config on PKI secret backend %q: %s", k, backend, err)
+		}
+	}
+
+	return nil
+}
+
+func pkiSecretBackendConfigACMEDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Disabling ACME on %q", path)
+	if _, err := client.Logical().Write(path, map[string]interface{}{
+		"enabled": false,
+	}); err != nil {
+		return fmt.Errorf("error disabling ACME on %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Disabled ACME on %q", path)
+
+	return nil
+}
+
+func pkiSecretBackendConfigACMEPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config/acme"
+}
+
+// validatePKIACMEDirectoryPolicy checks the default directory policy against
+// the forms Vault accepts.
+func validatePKIACMEDirectoryPolicy(i interface{}, k string) (s []string, es []error) {
+	v, ok := i.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	switch {
+	case v == "sign-verbatim", v == "forbid", v == "external-policy":
+	case strings.HasPrefix(v, "role:") && len(v) > len("role:"):
+	case strings.HasPrefix(v, "external-policy:") && len(v) > len("external-policy:"):
+	default:
+		es = append(es, fmt.Errorf(`expected %s to be one of "sign-verbatim", "forbid", "role:<name>", "external-policy" or "external-policy:<name>", got %q`, k, v))
+	}
+	return
+}
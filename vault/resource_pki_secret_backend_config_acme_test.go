@@ -0,0 +1,92 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestPkiSecretBackendConfigACME(t *testing.T) {
+	backend := acctest.RandomWithPrefix("pki")
+	resourceName := "vault_pki_secret_backend_config_acme.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendConfigACMEConfig(backend, `
+  enabled = true
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_issuers.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_issuers.0", "*"),
+					resource.TestCheckResourceAttr(resourceName, "default_directory_policy", "sign-verbatim"),
+					resource.TestCheckResourceAttr(resourceName, "eab_policy", "not-required"),
+				),
+			},
+			{
+				Config: testPkiSecretBackendConfigACMEConfig(backend, `
+  enabled                  = true
+  allowed_roles            = [vault_pki_secret_backend_role.test.name]
+  default_directory_policy = "role:${vault_pki_secret_backend_role.test.name}"
+  eab_policy               = "always-required"
+  dns_resolver             = "1.1.1.1:53"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "allowed_roles.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "allowed_roles.0", "test"),
+					resource.TestCheckResourceAttr(resourceName, "default_directory_policy", "role:test"),
+					resource.TestCheckResourceAttr(resourceName, "eab_policy", "always-required"),
+					resource.TestCheckResourceAttr(resourceName, "dns_resolver", "1.1.1.1:53"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestValidatePKIACMEDirectoryPolicy(t *testing.T) {
+	for _, v := range []string{"sign-verbatim", "forbid", "role:web", "external-policy", "external-policy:web"} {
+		if _, errs := validatePKIACMEDirectoryPolicy(v, "default_directory_policy"); len(errs) > 0 {
+			t.Errorf("expected %q to be valid, got %v", v, errs)
+		}
+	}
+	for _, v := range []string{"", "role:", "external-policy:", "sign", "roles:web"} {
+		if _, errs := validatePKIACMEDirectoryPolicy(v, "default_directory_policy"); len(errs) == 0 {
+			t.Errorf("expected %q to be invalid", v)
+		}
+	}
+}
+
+func testPkiSecretBackendConfigACMEConfig(backend, options string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "pki"
+}
+
+resource "vault_pki_secret_backend_config_cluster" "test" {
+  backend = vault_mount.test.path
+  path    = "http://127.0.0.1:8200/v1/${vault_mount.test.path}"
+}
+
+resource "vault_pki_secret_backend_role" "test" {
+  backend = vault_mount.test.path
+  name    = "test"
+}
+
+resource "vault_pki_secret_backend_config_acme" "test" {
+  backend = vault_pki_secret_backend_config_cluster.test.backend
+  %s
+}
+`, backend, options)
+}
@@ -0,0 +1,111 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func pkiSecretBackendConfigClusterResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendConfigClusterWrite,
+		Read:   pkiSecretBackendConfigClusterRead,
+		Update: pkiSecretBackendConfigClusterWrite,
+		Delete: pkiSecretBackendConfigClusterDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the PKI secret backend the resource belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The URL of this cluster's PKI mount, e.g. \"https://vault.example.com/v1/pki\". Used for ACME and templated AIA URLs.",
+			},
+			"aia_path": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The non-TLS URL of this cluster's PKI mount, used in templated AIA URLs.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendConfigClusterWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := strings.Trim(d.Get("backend").(string), "/")
+	path := pkiSecretBackendConfigClusterPath(backend)
+
+	data := map[string]interface{}{
+		"path":     d.Get("path").(string),
+		"aia_path": d.Get("aia_path").(string),
+	}
+
+	log.Printf("[DEBUG] Writing cluster config on PKI secret backend %q", backend)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing cluster config on PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Wrote cluster config on PKI secret backend %q", backend)
+
+	d.SetId(path)
+	return pkiSecretBackendConfigClusterRead(d, meta)
+}
+
+func pkiSecretBackendConfigClusterRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	backend := strings.TrimSuffix(path, "/config/cluster")
+
+	log.Printf("[DEBUG] Reading cluster config from PKI secret backend %q", backend)
+	config, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading cluster config on PKI secret backend %q: %s", backend, err)
+	}
+	if config == nil {
+		log.Printf("[WARN] Cluster config on PKI secret backend %q not found, removing from state", backend)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("path", config.Data["path"])
+	d.Set("aia_path", config.Data["aia_path"])
+
+	return nil
+}
+
+func pkiSecretBackendConfigClusterDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Clearing cluster config %q", path)
+	if _, err := client.Logical().Write(path, map[string]interface{}{
+		"path":     "",
+		"aia_path": "",
+	}); err != nil {
+		return fmt.Errorf("error clearing cluster config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Cleared cluster config %q", path)
+
+	return nil
+}
+
+func pkiSecretBackendConfigClusterPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config/cluster"
+}
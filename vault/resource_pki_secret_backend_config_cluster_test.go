@@ -0,0 +1,60 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestPkiSecretBackendConfigCluster(t *testing.T) {
+	backend := acctest.RandomWithPrefix("pki")
+	resourceName := "vault_pki_secret_backend_config_cluster.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendConfigClusterConfig(backend, "https://vault.example.com", ""),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "path", "https://vault.example.com/v1/"+backend),
+					resource.TestCheckResourceAttr(resourceName, "aia_path", ""),
+				),
+			},
+			{
+				Config: testPkiSecretBackendConfigClusterConfig(backend, "https://vault.example.com", "http://vault.example.com"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "aia_path", "http://vault.example.com/v1/"+backend),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendConfigClusterConfig(backend, url, aiaURL string) string {
+	aiaPath := ""
+	if aiaURL != "" {
+		aiaPath = fmt.Sprintf(`aia_path = "%s/v1/${vault_mount.test.path}"`, aiaURL)
+	}
+
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "pki"
+}
+
+resource "vault_pki_secret_backend_config_cluster" "test" {
+  backend = vault_mount.test.path
+  path    = "%s/v1/${vault_mount.test.path}"
+  %s
+}
+`, backend, url, aiaPath)
+}
@@ -0,0 +1,131 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func pkiSecretBackendConfigKeysResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendConfigKeysWrite,
+		Read:   pkiSecretBackendConfigKeysRead,
+		Update: pkiSecretBackendConfigKeysWrite,
+		Delete: pkiSecretBackendConfigKeysDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the PKI secret backend the resource belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"default": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The name or ID of the key to use by default for new issuers.",
+			},
+			"default_key_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the default key.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendConfigKeysWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := strings.Trim(d.Get("backend").(string), "/")
+	path := pkiSecretBackendConfigKeysPath(backend)
+
+	data := map[string]interface{}{
+		"default": d.Get("default").(string),
+	}
+
+	log.Printf("[DEBUG] Writing keys config on PKI secret backend %q", backend)
+	_, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error writing keys config on PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Wrote keys config on PKI secret backend %q", backend)
+
+	d.SetId(path)
+	return pkiSecretBackendConfigKeysRead(d, meta)
+}
+
+func pkiSecretBackendConfigKeysRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	backend := strings.TrimSuffix(path, "/config/keys")
+
+	log.Printf("[DEBUG] Reading keys config from PKI secret backend %q", backend)
+	config, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading keys config on PKI secret backend %q: %s", backend, err)
+	}
+	if config == nil {
+		log.Printf("[WARN] Keys config on PKI secret backend %q not found, removing from state", backend)
+		d.SetId("")
+		return nil
+	}
+
+	defaultKeyID, _ := config.Data["default"].(string)
+	d.Set("backend", backend)
+	d.Set("default_key_id", defaultKeyID)
+
+	// default can be configured as a key name, Vault always returns the
+	// key ID, so only report drift if the configured key resolves to
+	// another key.
+	configured := d.Get("default").(string)
+	if configured == defaultKeyID {
+		return nil
+	}
+	if configured != "" {
+		keyID, err := pkiSecretBackendKeyID(client, backend, configured)
+		if err != nil {
+			return err
+		}
+		if keyID == defaultKeyID {
+			return nil
+		}
+	}
+	d.Set("default", defaultKeyID)
+
+	return nil
+}
+
+func pkiSecretBackendConfigKeysDelete(d *schema.ResourceData, meta interface{}) error {
+	log.Printf("[WARN] The default key of PKI secret backend %q can't be unset, removing it from state only", d.Get("backend").(string))
+	return nil
+}
+
+// pkiSecretBackendKeyID resolves a key reference, either a name or an ID,
+// to the ID of the key. An empty ID is returned if the key doesn't exist.
+func pkiSecretBackendKeyID(client *api.Client, backend, ref string) (string, error) {
+	resp, err := client.Logical().Read(backend + "/key/" + ref)
+	if err != nil {
+		return "", fmt.Errorf("error reading key %q on PKI secret backend %q: %s", ref, backend, err)
+	}
+	if resp == nil {
+		return "", nil
+	}
+	keyID, _ := resp.Data["key_id"].(string)
+	return keyID, nil
+}
+
+func pkiSecretBackendConfigKeysPath(backend string) string {
+	return strings.Trim(backend, "/") + "/config/keys"
+}
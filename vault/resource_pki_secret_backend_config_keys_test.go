@@ -0,0 +1,103 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestPkiSecretBackendConfigKeys_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("pki")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendConfigKeysConfig(backend, "key-a"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_keys.test", "id", backend+"/config/keys"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_keys.test", "default", "key-a"),
+					testPkiSecretBackendConfigKeysCheckDefault(backend, "key-a"),
+				),
+			},
+			{
+				Config: testPkiSecretBackendConfigKeysConfig(backend, "key-b"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_config_keys.test", "default", "key-b"),
+					testPkiSecretBackendConfigKeysCheckDefault(backend, "key-b"),
+				),
+			},
+			{
+				// the key name must not diff against the key ID read back
+				Config:   testPkiSecretBackendConfigKeysConfig(backend, "key-b"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendConfigKeysCheckDefault(backend, keyName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources["vault_pki_secret_backend_config_keys.test"]
+		if !ok {
+			return fmt.Errorf("resource not found in state")
+		}
+
+		client := testProvider.Meta().(*api.Client)
+		keyID, err := pkiSecretBackendKeyID(client, backend, keyName)
+		if err != nil {
+			return err
+		}
+		if keyID == "" {
+			return fmt.Errorf("key %q not found", keyName)
+		}
+		if actual := rs.Primary.Attributes["default_key_id"]; actual != keyID {
+			return fmt.Errorf("expected default_key_id %q, got %q", keyID, actual)
+		}
+		return nil
+	}
+}
+
+func testPkiSecretBackendConfigKeysConfig(backend, defaultKey string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "pki" {
+  path = "%s"
+  type = "pki"
+}
+
+resource "vault_generic_endpoint" "key_a" {
+  path                 = "${vault_mount.pki.path}/keys/generate/internal"
+  disable_read         = true
+  disable_delete       = true
+  ignore_absent_fields = true
+
+  data_json = jsonencode({
+    key_name = "key-a"
+    key_type = "ec"
+  })
+}
+
+resource "vault_generic_endpoint" "key_b" {
+  path                 = "${vault_mount.pki.path}/keys/generate/internal"
+  disable_read         = true
+  disable_delete       = true
+  ignore_absent_fields = true
+
+  data_json = jsonencode({
+    key_name = "key-b"
+    key_type = "ec"
+  })
+}
+
+resource "vault_pki_secret_backend_config_keys" "test" {
+  depends_on = [vault_generic_endpoint.key_a, vault_generic_endpoint.key_b]
+  backend    = vault_mount.pki.path
+  default    = "%s"
+}
+`, backend, defaultKey)
+}
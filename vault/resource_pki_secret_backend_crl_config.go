@@ -6,9 +6,21 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
 	"github.com/hashicorp/vault/api"
 )
 
+var pkiSecretBackendCrlConfigFields = []string{
+	"expiry",
+	"disable",
+	"ocsp_disable",
+	"ocsp_expiry",
+	"auto_rebuild",
+	"auto_rebuild_grace_period",
+	"enable_delta",
+	"delta_rebuild_interval",
+}
+
 func pkiSecretBackendCrlConfigResource() *schema.Resource {
 	return &schema.Resource{
 		Create: pkiSecretBackendCrlConfigCreate,
@@ -16,6 +28,8 @@ func pkiSecretBackendCrlConfigResource() *schema.Resource {
 		Update: pkiSecretBackendCrlConfigUpdate,
 		Delete: pkiSecretBackendCrlConfigDelete,
 
+		CustomizeDiff: pkiSecretBackendCrlConfigCustomizeDiff,
+
 		Schema: map[string]*schema.Schema{
 			"backend": {
 				Type:        schema.TypeString,
@@ -28,15 +42,59 @@ func pkiSecretBackendCrlConfigResource() *schema.Resource {
 				},
 			},
 			"expiry": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				Description: "Specifies the time until expiration.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				Description:      "Specifies the time until expiration.",
+				ValidateFunc:     validateDurationSeconds,
+				DiffSuppressFunc: util.DurationSecondsDiffSuppress,
 			},
 			"disable": {
 				Type:        schema.TypeBool,
 				Optional:    true,
 				Description: "Disables or enables CRL building",
 			},
+			"ocsp_disable": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Disables the OCSP responder. Requires Vault 1.12+.",
+			},
+			"ocsp_expiry": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "How long OCSP responses are valid for. 0 disables their expiry. Requires Vault 1.12+.",
+				ValidateFunc:     validateDurationSeconds,
+				DiffSuppressFunc: util.DurationSecondsDiffSuppress,
+			},
+			"auto_rebuild": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Rebuilds the CRL automatically before it expires, instead of on every revocation. Requires Vault 1.12+.",
+			},
+			"auto_rebuild_grace_period": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "How long before the CRL expires to rebuild it when auto_rebuild is enabled. Requires Vault 1.12+.",
+				ValidateFunc:     validateDurationSeconds,
+				DiffSuppressFunc: util.DurationSecondsDiffSuppress,
+			},
+			"enable_delta": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Computed:    true,
+				Description: "Builds delta CRLs between full rebuilds. Requires auto_rebuild and Vault 1.12+.",
+			},
+			"delta_rebuild_interval": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Computed:         true,
+				Description:      "How often to rebuild delta CRLs when enable_delta is enabled. Requires Vault 1.12+.",
+				ValidateFunc:     validateDurationSeconds,
+				DiffSuppressFunc: util.DurationSecondsDiffSuppress,
+			},
 		},
 	}
 }
@@ -47,13 +105,7 @@ func pkiSecretBackendCrlConfigCreate(d *schema.ResourceData, meta interface{}) e
 	backend := d.Get("backend").(string)
 	path := pkiSecretBackendCrlConfigPath(backend)
 
-	data := make(map[string]interface{})
-	if expiry, ok := d.GetOk("expiry"); ok {
-		data["expiry"] = expiry
-	}
-	if disable, ok := d.GetOk("disable"); ok {
-		data["disable"] = disable
-	}
+	data := pkiSecretBackendCrlConfigRequestData(d)
 
 	log.Printf("[DEBUG] Creating CRL config on PKI secret backend %q", backend)
 	_, err := client.Logical().Write(path, data)
@@ -81,8 +133,17 @@ func pkiSecretBackendCrlConfigRead(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("invalid path ID %q: %s", path, err)
 	}
 
-	d.Set("expiry", config.Data["expiry"])
-	d.Set("disable", config.Data["disable"])
+	if config == nil {
+		log.Printf("[WARN] CRL config on PKI secret backend %q not found, removing from state", backend)
+		d.SetId("")
+		return nil
+	}
+
+	for _, k := range pkiSecretBackendCrlConfigFields {
+		if v, ok := config.Data[k]; ok {
+			d.Set(k, v)
+		}
+	}
 
 	return nil
 }
@@ -93,13 +154,7 @@ func pkiSecretBackendCrlConfigUpdate(d *schema.ResourceData, meta interface{}) e
 	path := d.Id()
 	backend := pkiSecretBackendCrlConfigPath(path)
 
-	data := make(map[string]interface{})
-	if expiry, ok := d.GetOk("expiry"); ok {
-		data["expiry"] = expiry
-	}
-	if disable, ok := d.GetOk("disable"); ok {
-		data["disable"] = disable
-	}
+	data := pkiSecretBackendCrlConfigRequestData(d)
 
 	log.Printf("[DEBUG] Updating CRL config on PKI secret backend %q", backend)
 	_, err := client.Logical().Write(path, data)
@@ -112,6 +167,34 @@ func pkiSecretBackendCrlConfigUpdate(d *schema.ResourceData, meta interface{}) e
 
 }
 
+// pkiSecretBackendCrlConfigCustomizeDiff rejects delta CRLs without automatic
+// rebuilding, which Vault would only refuse at apply time.
+func pkiSecretBackendCrlConfigCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Get("enable_delta").(bool) && !d.Get("auto_rebuild").(bool) {
+		return fmt.Errorf("enable_delta requires auto_rebuild to be enabled")
+	}
+	return nil
+}
+
+// pkiSecretBackendCrlConfigRequestData only sends the fields that are set,
+// so that Vault releases before 1.12 don't see the newer ones.
+func pkiSecretBackendCrlConfigRequestData(d *schema.ResourceData) map[string]interface{} {
+	data := map[string]interface{}{
+		"disable": d.Get("disable").(bool),
+	}
+	for _, k := range pkiSecretBackendCrlConfigFields {
+		if k == "disable" {
+			continue
+		}
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		} else if d.HasChange(k) {
+			data[k] = v
+		}
+	}
+	return data
+}
+
 func pkiSecretBackendCrlConfigDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 	"testing"
@@ -31,6 +32,66 @@ func TestPkiSecretBackendCrlConfig_basic(t *testing.T) {
 	})
 }
 
+func TestPkiSecretBackendCrlConfig_autoRebuild(t *testing.T) {
+	rootPath := acctest.RandomWithPrefix("pki-root")
+	resourceName := "vault_pki_secret_backend_crl_config.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testPkiSecretBackendCrlConfigDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testPkiSecretBackendCrlConfigConfig_autoRebuild(rootPath, false, "15m"),
+				ExpectError: regexp.MustCompile("enable_delta requires auto_rebuild to be enabled"),
+			},
+			{
+				Config: testPkiSecretBackendCrlConfigConfig_autoRebuild(rootPath, true, "15m"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "ocsp_disable", "false"),
+					resource.TestCheckResourceAttr(resourceName, "ocsp_expiry", "12h"),
+					resource.TestCheckResourceAttr(resourceName, "auto_rebuild", "true"),
+					resource.TestCheckResourceAttr(resourceName, "auto_rebuild_grace_period", "24h"),
+					resource.TestCheckResourceAttr(resourceName, "enable_delta", "true"),
+					resource.TestCheckResourceAttr(resourceName, "delta_rebuild_interval", "15m"),
+				),
+			},
+			{
+				// Equivalent durations must not diff.
+				Config:   testPkiSecretBackendCrlConfigConfig_autoRebuild(rootPath, true, "900"),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendCrlConfigConfig_autoRebuild(rootPath string, autoRebuild bool, deltaRebuildInterval string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test-root" {
+  path = "%s"
+  type = "pki"
+}
+
+resource "vault_pki_secret_backend_root_cert" "test-ca" {
+  backend     = vault_mount.test-root.path
+  type        = "internal"
+  common_name = "test-ca.example.com"
+  ttl         = "8640000"
+}
+
+resource "vault_pki_secret_backend_crl_config" "test" {
+  backend                   = vault_mount.test-root.path
+  ocsp_expiry               = "12h"
+  auto_rebuild              = %t
+  auto_rebuild_grace_period = "24h"
+  enable_delta              = true
+  delta_rebuild_interval    = "%s"
+
+  depends_on = [vault_pki_secret_backend_root_cert.test-ca]
+}
+`, rootPath, autoRebuild, deltaRebuildInterval)
+}
+
 func testPkiSecretBackendCrlConfigDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -0,0 +1,76 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func pkiSecretBackendCrlRebuildResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendCrlRebuildCreate,
+		Read:   pkiSecretBackendCrlRebuildRead,
+		Delete: pkiSecretBackendCrlRebuildDelete,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "The path of the PKI secret backend the resource belongs to.",
+				ForceNew:    true,
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"delta": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Rebuild the delta CRL instead of the complete CRL.",
+				ForceNew:    true,
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				Description: "Arbitrary map of values that, when changed, will trigger a CRL rebuild.",
+				ForceNew:    true,
+			},
+		},
+	}
+}
+
+func pkiSecretBackendCrlRebuildCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := d.Get("backend").(string)
+	path := pkiSecretBackendCrlRebuildPath(backend, d.Get("delta").(bool))
+
+	log.Printf("[DEBUG] Rebuilding CRL on PKI secret backend %q", backend)
+	_, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error rebuilding CRL on PKI secret backend %q: %s", backend, err)
+	}
+	log.Printf("[DEBUG] Rebuilt CRL on PKI secret backend %q", backend)
+
+	d.SetId(path)
+	return pkiSecretBackendCrlRebuildRead(d, meta)
+}
+
+func pkiSecretBackendCrlRebuildRead(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func pkiSecretBackendCrlRebuildDelete(d *schema.ResourceData, meta interface{}) error {
+	return nil
+}
+
+func pkiSecretBackendCrlRebuildPath(backend string, delta bool) string {
+	if delta {
+		return strings.Trim(backend, "/") + "/crl/rotate-delta"
+	}
+	return strings.Trim(backend, "/") + "/crl/rotate"
+}
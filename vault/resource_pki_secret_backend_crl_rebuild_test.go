@@ -0,0 +1,173 @@
+package vault
+
+import (
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"fmt"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestPkiSecretBackendCrlRebuild_basic(t *testing.T) {
+	rootPath := "pki-root-" + strconv.Itoa(acctest.RandInt())
+
+	// Only crl/rotate updates the CRL once the certificate is revoked, so
+	// its ThisUpdate tells whether the rebuild ran.
+	var lastUpdate time.Time
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendCrlRebuildConfig(rootPath, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_crl_rebuild.test", "id", rootPath+"/crl/rotate"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_crl_rebuild.test", "triggers.serial", "first"),
+					testPkiSecretBackendCrlContainsRevoked(rootPath, "vault_pki_secret_backend_cert.first"),
+					testPkiSecretBackendCrlUpdated(rootPath, &lastUpdate, true),
+				),
+			},
+			{
+				// Unchanged triggers must not cause another rebuild. CRL
+				// times have a resolution of a second, wait for it to pass.
+				PreConfig: func() { time.Sleep(time.Second) },
+				Config:    testPkiSecretBackendCrlRebuildConfig(rootPath, "first"),
+				Check:     testPkiSecretBackendCrlUpdated(rootPath, &lastUpdate, false),
+			},
+			{
+				PreConfig: func() { time.Sleep(time.Second) },
+				Config:    testPkiSecretBackendCrlRebuildConfig(rootPath, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_crl_rebuild.test", "triggers.serial", "second"),
+					testPkiSecretBackendCrlContainsRevoked(rootPath, "vault_pki_secret_backend_cert.first"),
+					testPkiSecretBackendCrlUpdated(rootPath, &lastUpdate, true),
+				),
+			},
+		},
+	})
+}
+
+func testPkiSecretBackendReadCrl(backend string) (*pkix.CertificateList, error) {
+	client := testProvider.Meta().(*api.Client)
+	resp, err := client.Logical().Read(backend + "/cert/crl")
+	if err != nil {
+		return nil, err
+	}
+	if resp == nil {
+		return nil, fmt.Errorf("no CRL found on %q", backend)
+	}
+
+	block, _ := pem.Decode([]byte(resp.Data["certificate"].(string)))
+	if block == nil {
+		return nil, fmt.Errorf("CRL on %q is not PEM encoded", backend)
+	}
+	return x509.ParseCRL(block.Bytes)
+}
+
+// testPkiSecretBackendCrlUpdated checks whether the CRL was rebuilt since
+// lastUpdate, and records its new ThisUpdate.
+func testPkiSecretBackendCrlUpdated(backend string, lastUpdate *time.Time, updated bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		crl, err := testPkiSecretBackendReadCrl(backend)
+		if err != nil {
+			return err
+		}
+
+		thisUpdate := crl.TBSCertList.ThisUpdate
+		if updated && !thisUpdate.After(*lastUpdate) {
+			return fmt.Errorf("expected the CRL of %q to be rebuilt after %s, last updated %s", backend, *lastUpdate, thisUpdate)
+		}
+		if !updated && !thisUpdate.Equal(*lastUpdate) {
+			return fmt.Errorf("expected the CRL of %q not to be rebuilt, updated %s since %s", backend, thisUpdate, *lastUpdate)
+		}
+		*lastUpdate = thisUpdate
+
+		return nil
+	}
+}
+
+func testPkiSecretBackendCrlContainsRevoked(backend, certResource string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[certResource]
+		if !ok {
+			return fmt.Errorf("resource %q not found in state", certResource)
+		}
+		serial := strings.ReplaceAll(rs.Primary.Attributes["serial_number"], ":", "")
+
+		crl, err := testPkiSecretBackendReadCrl(backend)
+		if err != nil {
+			return err
+		}
+
+		for _, revoked := range crl.TBSCertList.RevokedCertificates {
+			if fmt.Sprintf("%x", revoked.SerialNumber) == strings.TrimLeft(serial, "0") {
+				return nil
+			}
+		}
+		return fmt.Errorf("serial %q not found in CRL of %q", serial, backend)
+	}
+}
+
+func testPkiSecretBackendCrlRebuildConfig(rootPath, trigger string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test-root" {
+  path                      = "%s"
+  type                      = "pki"
+  description               = "test root"
+  default_lease_ttl_seconds = "8640000"
+  max_lease_ttl_seconds     = "8640000"
+}
+
+resource "vault_pki_secret_backend_root_cert" "test-ca" {
+  backend     = vault_mount.test-root.path
+  type        = "internal"
+  common_name = "test-ca.example.com"
+  ttl         = "8640000"
+  key_type    = "rsa"
+  key_bits    = 2048
+}
+
+resource "vault_pki_secret_backend_role" "test" {
+  depends_on       = [vault_pki_secret_backend_root_cert.test-ca]
+  backend          = vault_mount.test-root.path
+  name             = "test"
+  allowed_domains  = ["example.com"]
+  allow_subdomains = true
+  max_ttl          = "3600"
+}
+
+resource "vault_pki_secret_backend_cert" "first" {
+  backend     = vault_mount.test-root.path
+  name        = vault_pki_secret_backend_role.test.name
+  common_name = "first.example.com"
+  ttl         = "30m"
+}
+
+resource "vault_generic_endpoint" "revoke" {
+  path           = "${vault_mount.test-root.path}/revoke"
+  disable_read   = true
+  disable_delete = true
+
+  data_json = jsonencode({
+    serial_number = vault_pki_secret_backend_cert.first.serial_number
+  })
+}
+
+resource "vault_pki_secret_backend_crl_rebuild" "test" {
+  depends_on = [vault_generic_endpoint.revoke]
+  backend    = vault_mount.test-root.path
+
+  triggers = {
+    serial = "%s"
+  }
+}
+`, rootPath, trigger)
+}
@@ -25,9 +25,15 @@ func pkiSecretBackendIntermediateCertRequestResource() *schema.Resource {
 			"type": {
 				Type:         schema.TypeString,
 				Required:     true,
-				Description:  "Type of intermediate to create. Must be either \"exported\" or \"internal\".",
+				Description:  "Type of intermediate to create. Must be either \"exported\", \"internal\" or \"existing\".",
 				ForceNew:     true,
-				ValidateFunc: validation.StringInSlice([]string{"exported", "internal"}, false),
+				ValidateFunc: validation.StringInSlice([]string{"exported", "internal", "existing"}, false),
+			},
+			"key_ref": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name or ID of the existing key to generate the CSR with. Only used when type is \"existing\", e.g. to cross-sign an issuer. Requires Vault 1.11+.",
+				ForceNew:    true,
 			},
 			"common_name": {
 				Type:        schema.TypeString,
@@ -205,9 +211,6 @@ func pkiSecretBackendIntermediateCertRequestCreate(d *schema.ResourceData, meta
 	data := map[string]interface{}{
 		"common_name":          d.Get("common_name").(string),
 		"format":               d.Get("format").(string),
-		"private_key_format":   d.Get("private_key_format").(string),
-		"key_type":             d.Get("key_type").(string),
-		"key_bits":             d.Get("key_bits").(int),
 		"exclude_cn_from_sans": d.Get("exclude_cn_from_sans").(bool),
 		"ou":                   d.Get("ou").(string),
 		"organization":         d.Get("organization").(string),
@@ -218,6 +221,23 @@ func pkiSecretBackendIntermediateCertRequestCreate(d *schema.ResourceData, meta
 		"postal_code":          d.Get("postal_code").(string),
 	}
 
+	// An existing key already has a type and size, so the CSR is generated
+	// with whatever the key is.
+	keyRef := d.Get("key_ref").(string)
+	if intermediateType == "existing" {
+		if keyRef == "" {
+			return fmt.Errorf("key_ref is required when type is %q", intermediateType)
+		}
+		data["key_ref"] = keyRef
+	} else {
+		if keyRef != "" {
+			return fmt.Errorf("key_ref can only be used when type is %q", "existing")
+		}
+		data["private_key_format"] = d.Get("private_key_format").(string)
+		data["key_type"] = d.Get("key_type").(string)
+		data["key_bits"] = d.Get("key_bits").(int)
+	}
+
 	if len(altNames) > 0 {
 		data["alt_names"] = strings.Join(altNames, ",")
 	}
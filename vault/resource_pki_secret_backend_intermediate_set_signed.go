@@ -28,6 +28,22 @@ func pkiSecretBackendIntermediateSetSignedResource() *schema.Resource {
 				Description: "The certificate.",
 				ForceNew:    true,
 			},
+			"imported_issuers": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The IDs of the issuers imported from the certificate. Requires Vault 1.11 or later.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"imported_keys": {
+				Type:        schema.TypeList,
+				Computed:    true,
+				Description: "The IDs of the keys imported along with the certificate. Requires Vault 1.11 or later.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -44,12 +60,30 @@ func pkiSecretBackendIntermediateSetSignedCreate(d *schema.ResourceData, meta in
 	}
 
 	log.Printf("[DEBUG] Creating intermediate set-signed on PKI secret backend %q", backend)
-	_, err := client.Logical().Write(path, data)
+	resp, err := client.Logical().Write(path, data)
 	if err != nil {
 		return fmt.Errorf("error creating intermediate set-signed on PKI secret backend %q: %s", backend, err)
 	}
 	log.Printf("[DEBUG] Created intermediate set-signed on PKI secret backend %q", backend)
 
+	// Vault only reports what it imported when the request creates new issuers
+	// or keys, and releases before 1.11 never do.
+	var importedIssuers, importedKeys []interface{}
+	if resp != nil {
+		if v, ok := resp.Data["imported_issuers"].([]interface{}); ok {
+			importedIssuers = v
+		}
+		if v, ok := resp.Data["imported_keys"].([]interface{}); ok {
+			importedKeys = v
+		}
+	}
+	if err := d.Set("imported_issuers", importedIssuers); err != nil {
+		return err
+	}
+	if err := d.Set("imported_keys", importedKeys); err != nil {
+		return err
+	}
+
 	d.SetId(path)
 	return pkiSecretBackendIntermediateSetSignedRead(d, meta)
 }
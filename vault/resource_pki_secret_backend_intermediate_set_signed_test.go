@@ -25,12 +25,40 @@ func TestPkiSecretBackendIntermediateSetSigned_basic(t *testing.T) {
 				Config: testPkiSecretBackendIntermediateSetSignedConfig_basic(rootPath, intermediatePath),
 				Check: resource.ComposeTestCheckFunc(
 					resource.TestCheckResourceAttr("vault_pki_secret_backend_intermediate_set_signed.test", "backend", intermediatePath),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_intermediate_set_signed.test", "imported_issuers.#", "1"),
+					testPkiSecretBackendIntermediateSetSignedCheckDefaultIssuer(intermediatePath),
 				),
 			},
 		},
 	})
 }
 
+// testPkiSecretBackendIntermediateSetSignedCheckDefaultIssuer checks that the
+// imported issuer ID can be made the default issuer of the backend.
+func testPkiSecretBackendIntermediateSetSignedCheckDefaultIssuer(backend string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources["vault_pki_secret_backend_intermediate_set_signed.test"]
+		if !ok {
+			return fmt.Errorf("resource not found in state")
+		}
+		issuer := rs.Primary.Attributes["imported_issuers.0"]
+
+		client := testProvider.Meta().(*api.Client)
+		path := backend + "/config/issuers"
+		if _, err := client.Logical().Write(path, map[string]interface{}{"default": issuer}); err != nil {
+			return fmt.Errorf("error setting default issuer %q: %s", issuer, err)
+		}
+		resp, err := client.Logical().Read(path)
+		if err != nil {
+			return err
+		}
+		if resp == nil || resp.Data["default"] != issuer {
+			return fmt.Errorf("expected default issuer of %q to be %q", backend, issuer)
+		}
+		return nil
+	}
+}
+
 func testPkiSecretBackendIntermediateSetSignedDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
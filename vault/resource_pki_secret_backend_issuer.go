@@ -0,0 +1,231 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+var pkiSecretBackendIssuerUsages = []string{
+	"read-only",
+	"issuing-certificates",
+	"crl-signing",
+	"ocsp-signing",
+}
+
+func pkiSecretBackendIssuerResource() *schema.Resource {
+	return &schema.Resource{
+		Create: pkiSecretBackendIssuerCreate,
+		Read:   pkiSecretBackendIssuerRead,
+		Update: pkiSecretBackendIssuerUpdate,
+		Delete: pkiSecretBackendIssuerDelete,
+		Importer: &schema.ResourceImporter{
+			State: pkiSecretBackendIssuerImport,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the PKI secret backend the resource belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"issuer_ref": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The name or ID of the existing issuer to manage.",
+			},
+			"issuer_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The name of the issuer.",
+			},
+			"usage": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Computed:    true,
+				Description: "The operations the issuer may be used for. Any of \"read-only\", \"issuing-certificates\", \"crl-signing\" and \"ocsp-signing\".",
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringInSlice(pkiSecretBackendIssuerUsages, false),
+				},
+			},
+			"manual_chain": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "The IDs or names of the issuers making up the issuer's CA chain. If not set, Vault builds the chain itself.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"revocation_signature_algorithm": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				Description: "The signature algorithm used to sign CRLs and OCSP responses, e.g. \"SHA256WithRSA\". If not set, Vault picks one based on the issuer's key.",
+			},
+			"leaf_not_after_behavior": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				Description:  "What to do when a leaf certificate would outlive the issuer. One of \"err\", \"truncate\" or \"permit\".",
+				ValidateFunc: validation.StringInSlice([]string{"err", "truncate", "permit"}, false),
+			},
+			"issuer_id": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The ID of the issuer.",
+			},
+			"certificate": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "The issuer's certificate, in PEM format.",
+			},
+		},
+	}
+}
+
+func pkiSecretBackendIssuerCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := strings.Trim(d.Get("backend").(string), "/")
+	ref := d.Get("issuer_ref").(string)
+
+	// Issuers are created by generating or importing certificates. This
+	// resource only takes over the configuration of an existing one, so it's
+	// tracked by ID to survive renames.
+	path := pkiSecretBackendIssuerPath(backend, ref)
+	log.Printf("[DEBUG] Reading issuer %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading issuer %q: %s", path, err)
+	}
+	if resp == nil {
+		return fmt.Errorf("issuer %q not found", path)
+	}
+	issuerID, ok := resp.Data["issuer_id"].(string)
+	if !ok || issuerID == "" {
+		return fmt.Errorf("no issuer_id returned for issuer %q", path)
+	}
+
+	d.SetId(pkiSecretBackendIssuerPath(backend, issuerID))
+
+	return pkiSecretBackendIssuerUpdate(d, meta)
+}
+
+func pkiSecretBackendIssuerRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	backend, issuerID, err := pkiSecretBackendIssuerParsePath(path)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Reading issuer %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading issuer %q: %s", path, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] Issuer %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+	log.Printf("[DEBUG] Read issuer %q", path)
+
+	d.Set("backend", backend)
+	d.Set("issuer_id", issuerID)
+	for _, k := range []string{"issuer_name", "revocation_signature_algorithm", "leaf_not_after_behavior", "certificate"} {
+		d.Set(k, resp.Data[k])
+	}
+
+	var usage []string
+	if v, ok := resp.Data["usage"].(string); ok && v != "" {
+		usage = strings.Split(v, ",")
+		sort.Strings(usage)
+	}
+	if err := d.Set("usage", usage); err != nil {
+		return fmt.Errorf("error setting usage for issuer %q: %s", path, err)
+	}
+
+	// Vault returns the full chain it uses, whether it was built
+	// automatically or not, so only the configured manual chain is tracked.
+	if err := d.Set("manual_chain", resp.Data["manual_chain"]); err != nil {
+		return fmt.Errorf("error setting manual_chain for issuer %q: %s", path, err)
+	}
+
+	return nil
+}
+
+func pkiSecretBackendIssuerUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	data := map[string]interface{}{
+		"manual_chain": d.Get("manual_chain").([]interface{}),
+	}
+	for _, k := range []string{"issuer_name", "revocation_signature_algorithm", "leaf_not_after_behavior"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(string)
+		}
+	}
+	if v, ok := d.GetOk("usage"); ok {
+		var usage []string
+		for _, u := range v.(*schema.Set).List() {
+			usage = append(usage, u.(string))
+		}
+		sort.Strings(usage)
+		data["usage"] = strings.Join(usage, ",")
+	}
+
+	log.Printf("[DEBUG] Updating issuer %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error updating issuer %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Updated issuer %q", path)
+
+	return pkiSecretBackendIssuerRead(d, meta)
+}
+
+func pkiSecretBackendIssuerDelete(d *schema.ResourceData, meta interface{}) error {
+	// Deleting the issuer would also drop its certificate and break anything
+	// chained to it, so it's left in place with its current configuration.
+	log.Printf("[WARN] Issuer %q is left on the PKI secret backend, removing it from state only", d.Id())
+	return nil
+}
+
+func pkiSecretBackendIssuerImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	_, issuerID, err := pkiSecretBackendIssuerParsePath(d.Id())
+	if err != nil {
+		return nil, err
+	}
+	d.Set("issuer_ref", issuerID)
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func pkiSecretBackendIssuerPath(backend, ref string) string {
+	return strings.Trim(backend, "/") + "/issuer/" + ref
+}
+
+func pkiSecretBackendIssuerParsePath(path string) (string, string, error) {
+	i := strings.LastIndex(path, "/issuer/")
+	if i <= 0 || i+len("/issuer/") == len(path) {
+		return "", "", fmt.Errorf("expected a path of the form <backend>/issuer/<issuer_id>, got %q", path)
+	}
+
+	return path[:i], path[i+len("/issuer/"):], nil
+}
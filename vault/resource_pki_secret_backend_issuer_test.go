@@ -0,0 +1,111 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestPkiSecretBackendIssuer_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("pki")
+	resourceName := "vault_pki_secret_backend_issuer.test"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendIssuerConfig(backend, `issuer_name = "root-2022"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttrSet(resourceName, "issuer_id"),
+					resource.TestCheckResourceAttr(resourceName, "issuer_name", "root-2022"),
+					resource.TestCheckResourceAttr(resourceName, "usage.#", "4"),
+					resource.TestCheckResourceAttr(resourceName, "leaf_not_after_behavior", "err"),
+					resource.TestMatchResourceAttr(resourceName, "certificate", regexp.MustCompile("^-----BEGIN CERTIFICATE-----")),
+				),
+			},
+			{
+				Config: testPkiSecretBackendIssuerConfig(backend, `
+  issuer_name                    = "root-2022-retired"
+  usage                          = ["read-only", "crl-signing", "ocsp-signing"]
+  leaf_not_after_behavior        = "truncate"
+  revocation_signature_algorithm = "SHA512WithRSA"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "issuer_name", "root-2022-retired"),
+					resource.TestCheckResourceAttr(resourceName, "usage.#", "3"),
+					resource.TestCheckResourceAttr(resourceName, "leaf_not_after_behavior", "truncate"),
+					resource.TestCheckResourceAttr(resourceName, "revocation_signature_algorithm", "SHA512WithRSA"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// issuer_ref is set to the ID on import.
+				ImportStateVerifyIgnore: []string{"issuer_ref"},
+			},
+		},
+	})
+}
+
+func TestPkiSecretBackendIssuerParsePath(t *testing.T) {
+	tests := []struct {
+		path      string
+		backend   string
+		issuerID  string
+		expectErr bool
+	}{
+		{path: "pki/issuer/abc", backend: "pki", issuerID: "abc"},
+		{path: "nested/pki/issuer/abc", backend: "nested/pki", issuerID: "abc"},
+		{path: "pki/issuer/", expectErr: true},
+		{path: "/issuer/abc", expectErr: true},
+		{path: "pki", expectErr: true},
+	}
+
+	for _, tt := range tests {
+		backend, issuerID, err := pkiSecretBackendIssuerParsePath(tt.path)
+		if tt.expectErr {
+			if err == nil {
+				t.Errorf("expected an error parsing %q", tt.path)
+			}
+			continue
+		}
+		if err != nil {
+			t.Errorf("unexpected error parsing %q: %s", tt.path, err)
+			continue
+		}
+		if backend != tt.backend || issuerID != tt.issuerID {
+			t.Errorf("parsing %q: expected %s, %s, got %s, %s", tt.path, tt.backend, tt.issuerID, backend, issuerID)
+		}
+	}
+}
+
+func testPkiSecretBackendIssuerConfig(backend, options string) string {
+	return fmt.Sprintf(`
+resource "vault_pki_secret_backend" "test" {
+  path                      = "%s"
+  default_lease_ttl_seconds = "86400"
+  max_lease_ttl_seconds     = "86400"
+}
+
+resource "vault_pki_secret_backend_root_cert" "test" {
+  backend     = vault_pki_secret_backend.test.path
+  type        = "internal"
+  common_name = "test Root CA"
+  ttl         = "86400"
+}
+
+resource "vault_pki_secret_backend_issuer" "test" {
+  backend    = vault_pki_secret_backend.test.path
+  issuer_ref = "default"
+  %s
+
+  depends_on = [vault_pki_secret_backend_root_cert.test]
+}
+`, backend, options)
+}
@@ -16,6 +16,7 @@ import (
 var (
 	pkiSecretBackendRoleBackendFromPathRegex = regexp.MustCompile("^(.+)/roles/.+$")
 	pkiSecretBackendRoleNameFromPathRegex    = regexp.MustCompile("^.+/roles/(.+)$")
+	pkiSecretBackendRoleOIDRegex             = regexp.MustCompile(`^[0-2](\.(0|[1-9][0-9]*))+$`)
 )
 
 func pkiSecretBackendRoleResource() *schema.Resource {
@@ -60,6 +61,12 @@ func pkiSecretBackendRoleResource() *schema.Resource {
 					return old == "0"
 				},
 			},
+			"not_after": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Description:  "Absolute expiry of issued certificates as a UTC timestamp in the format YYYY-MM-ddTHH:MM:SSZ.",
+				ValidateFunc: validateUTCTimestamp,
+			},
 			"allow_localhost": {
 				Type:        schema.TypeBool,
 				Required:    false,
@@ -292,10 +299,38 @@ func pkiSecretBackendRoleResource() *schema.Resource {
 				Type:        schema.TypeList,
 				Required:    false,
 				Optional:    true,
-				Description: "Specify the list of allowed policies IODs.",
+				Description: "Specify the list of allowed policies OIDs.",
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:         schema.TypeString,
+					ValidateFunc: validation.StringMatch(pkiSecretBackendRoleOIDRegex, "must be a dotted OID, e.g. 1.3.6.1.4.1.44947.1.1.1"),
+				},
+				ConflictsWith: []string{"policy_identifier"},
+			},
+			"policy_identifier": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Policy identifier blocks, each with an OID and optional CPS URL and user notice.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"oid": {
+							Type:         schema.TypeString,
+							Required:     true,
+							Description:  "The OID of the policy identifier.",
+							ValidateFunc: validation.StringMatch(pkiSecretBackendRoleOIDRegex, "must be a dotted OID, e.g. 1.3.6.1.4.1.44947.1.1.1"),
+						},
+						"cps": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "The URL of the certification practice statement.",
+						},
+						"notice": {
+							Type:        schema.TypeString,
+							Optional:    true,
+							Description: "A user notice for the policy.",
+						},
+					},
 				},
+				ConflictsWith: []string{"policy_identifiers"},
 			},
 			"basic_constraints_valid_for_non_ca": {
 				Type:        schema.TypeBool,
@@ -344,15 +379,15 @@ func pkiSecretBackendRoleCreate(d *schema.ResourceData, meta interface{}) error
 		extKeyUsage = append(extKeyUsage, iUsage.(string))
 	}
 
-	iPolicyIdentifiers := d.Get("policy_identifiers").([]interface{})
-	policyIdentifiers := make([]string, 0, len(iPolicyIdentifiers))
-	for _, iIdentifier := range iPolicyIdentifiers {
-		policyIdentifiers = append(policyIdentifiers, iIdentifier.(string))
+	policyIdentifiers, err := pkiSecretBackendRoleExpandPolicyIdentifiers(d)
+	if err != nil {
+		return err
 	}
 
 	data := map[string]interface{}{
 		"ttl":                                d.Get("ttl"),
 		"max_ttl":                            d.Get("max_ttl"),
+		"not_after":                          d.Get("not_after"),
 		"allow_localhost":                    d.Get("allow_localhost"),
 		"allow_bare_domains":                 d.Get("allow_bare_domains"),
 		"allow_subdomains":                   d.Get("allow_subdomains"),
@@ -397,12 +432,12 @@ func pkiSecretBackendRoleCreate(d *schema.ResourceData, meta interface{}) error
 		data["ext_key_usage"] = extKeyUsage
 	}
 
-	if len(policyIdentifiers) > 0 {
+	if policyIdentifiers != nil {
 		data["policy_identifiers"] = policyIdentifiers
 	}
 
 	log.Printf("[DEBUG] Creating role %s on PKI secret backend %q", name, backend)
-	_, err := client.Logical().Write(path, data)
+	_, err = client.Logical().Write(path, data)
 	if err != nil {
 		return fmt.Errorf("error creating role %s for backend %q: %s", name, backend, err)
 	}
@@ -465,10 +500,9 @@ func pkiSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
 		extKeyUsage = append(extKeyUsage, iUsage.(string))
 	}
 
-	iPolicyIdentifiers := secret.Data["policy_identifiers"].([]interface{})
-	policyIdentifiers := make([]string, 0, len(iPolicyIdentifiers))
-	for _, iIdentifier := range iPolicyIdentifiers {
-		policyIdentifiers = append(policyIdentifiers, iIdentifier.(string))
+	policyIdentifiers, policyIdentifierBlocks, err := pkiSecretBackendRoleFlattenPolicyIdentifiers(secret.Data["policy_identifiers"].([]interface{}))
+	if err != nil {
+		return fmt.Errorf("error reading policy identifiers of role %q: %s", path, err)
 	}
 
 	notBeforeDuration := flattenVaultDuration(secret.Data["not_before_duration"])
@@ -477,6 +511,9 @@ func pkiSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("name", name)
 	d.Set("ttl", secret.Data["ttl"])
 	d.Set("max_ttl", secret.Data["max_ttl"])
+	if v, ok := secret.Data["not_after"]; ok {
+		d.Set("not_after", v)
+	}
 	d.Set("allow_localhost", secret.Data["allow_localhost"])
 	d.Set("allowed_domains", allowedDomains)
 	d.Set("allowed_domains_template", secret.Data["allowed_domains_template"])
@@ -509,6 +546,7 @@ func pkiSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("no_store", secret.Data["no_store"])
 	d.Set("require_cn", secret.Data["require_cn"])
 	d.Set("policy_identifiers", policyIdentifiers)
+	d.Set("policy_identifier", policyIdentifierBlocks)
 	d.Set("basic_constraints_valid_for_non_ca", secret.Data["basic_constraints_valid_for_non_ca"])
 	d.Set("not_before_duration", notBeforeDuration)
 
@@ -539,15 +577,15 @@ func pkiSecretBackendRoleUpdate(d *schema.ResourceData, meta interface{}) error
 		extKeyUsage = append(extKeyUsage, iUsage.(string))
 	}
 
-	iPolicyIdentifiers := d.Get("policy_identifiers").([]interface{})
-	policyIdentifiers := make([]string, 0, len(iPolicyIdentifiers))
-	for _, iIdentifier := range iPolicyIdentifiers {
-		policyIdentifiers = append(policyIdentifiers, iIdentifier.(string))
+	policyIdentifiers, err := pkiSecretBackendRoleExpandPolicyIdentifiers(d)
+	if err != nil {
+		return err
 	}
 
 	data := map[string]interface{}{
 		"ttl":                                d.Get("ttl"),
 		"max_ttl":                            d.Get("max_ttl"),
+		"not_after":                          d.Get("not_after"),
 		"allow_localhost":                    d.Get("allow_localhost"),
 		"allow_bare_domains":                 d.Get("allow_bare_domains"),
 		"allowed_domains_template":           d.Get("allowed_domains_template"),
@@ -592,11 +630,11 @@ func pkiSecretBackendRoleUpdate(d *schema.ResourceData, meta interface{}) error
 		data["ext_key_usage"] = extKeyUsage
 	}
 
-	if len(policyIdentifiers) > 0 {
+	if policyIdentifiers != nil {
 		data["policy_identifiers"] = policyIdentifiers
 	}
 
-	_, err := client.Logical().Write(path, data)
+	_, err = client.Logical().Write(path, data)
 	if err != nil {
 		return fmt.Errorf("error updating PKI secret backend role %q: %s", path, err)
 	}
@@ -656,3 +694,73 @@ func pkiSecretBackendRoleBackendFromPath(path string) (string, error) {
 	}
 	return res[1], nil
 }
+
+// pkiSecretBackendRoleExpandPolicyIdentifiers returns the policy identifiers
+// in the form Vault expects: a plain list of OIDs, or a JSON encoded list of
+// objects when policy_identifier blocks are used.
+func pkiSecretBackendRoleExpandPolicyIdentifiers(d *schema.ResourceData) (interface{}, error) {
+	if blocks := d.Get("policy_identifier").(*schema.Set).List(); len(blocks) > 0 {
+		identifiers := make([]map[string]interface{}, 0, len(blocks))
+		for _, block := range blocks {
+			b := block.(map[string]interface{})
+			identifier := map[string]interface{}{
+				"oid": b["oid"],
+			}
+			if v := b["cps"].(string); v != "" {
+				identifier["cps"] = v
+			}
+			if v := b["notice"].(string); v != "" {
+				identifier["notice"] = v
+			}
+			identifiers = append(identifiers, identifier)
+		}
+
+		encoded, err := json.Marshal(identifiers)
+		if err != nil {
+			return nil, fmt.Errorf("error encoding policy_identifier: %s", err)
+		}
+		return string(encoded), nil
+	}
+
+	iPolicyIdentifiers := d.Get("policy_identifiers").([]interface{})
+	if len(iPolicyIdentifiers) == 0 {
+		return nil, nil
+	}
+	policyIdentifiers := make([]string, 0, len(iPolicyIdentifiers))
+	for _, iIdentifier := range iPolicyIdentifiers {
+		policyIdentifiers = append(policyIdentifiers, iIdentifier.(string))
+	}
+	return policyIdentifiers, nil
+}
+
+// pkiSecretBackendRoleFlattenPolicyIdentifiers splits the policy identifiers
+// returned by Vault into plain OIDs and policy_identifier blocks. Vault
+// returns the structured form as one JSON object per identifier.
+func pkiSecretBackendRoleFlattenPolicyIdentifiers(iPolicyIdentifiers []interface{}) ([]string, []map[string]interface{}, error) {
+	policyIdentifiers := make([]string, 0, len(iPolicyIdentifiers))
+	var blocks []map[string]interface{}
+
+	for _, iIdentifier := range iPolicyIdentifiers {
+		identifier := iIdentifier.(string)
+		if !strings.HasPrefix(identifier, "{") {
+			policyIdentifiers = append(policyIdentifiers, identifier)
+			continue
+		}
+
+		var decoded struct {
+			OID    string `json:"oid"`
+			CPS    string `json:"cps"`
+			Notice string `json:"notice"`
+		}
+		if err := json.Unmarshal([]byte(identifier), &decoded); err != nil {
+			return nil, nil, fmt.Errorf("unexpected policy identifier %q: %s", identifier, err)
+		}
+		blocks = append(blocks, map[string]interface{}{
+			"oid":    decoded.OID,
+			"cps":    decoded.CPS,
+			"notice": decoded.Notice,
+		})
+	}
+
+	return policyIdentifiers, blocks, nil
+}
@@ -1,7 +1,10 @@
 package vault
 
 import (
+	"crypto/x509"
+	"encoding/pem"
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
@@ -234,3 +237,142 @@ func testPkiSecretBackendRoleCheckDestroy(s *terraform.State) error {
 	}
 	return nil
 }
+
+func TestPkiSecretBackendRole_policyIdentifiers(t *testing.T) {
+	backend := acctest.RandomWithPrefix("pki")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testPkiSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendRoleConfig_policyIdentifiers(backend, "simple", `policy_identifiers = ["1.2.3.4", "1.2.3.5"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_role.test", "policy_identifiers.#", "2"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_role.test", "policy_identifiers.0", "1.2.3.4"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_role.test", "policy_identifiers.1", "1.2.3.5"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_role.test", "policy_identifier.#", "0"),
+					testPkiSecretBackendCertHasPolicyIdentifiers("vault_pki_secret_backend_cert.test", "1.2.3.4", "1.2.3.5"),
+				),
+			},
+			{
+				Config:   testPkiSecretBackendRoleConfig_policyIdentifiers(backend, "simple", `policy_identifiers = ["1.2.3.4", "1.2.3.5"]`),
+				PlanOnly: true,
+			},
+			{
+				Config: testPkiSecretBackendRoleConfig_policyIdentifiers(backend, "structured", `
+  policy_identifier {
+    oid    = "1.2.3.4"
+    cps    = "https://example.com/cps"
+    notice = "test notice"
+  }
+
+  policy_identifier {
+    oid = "1.2.3.5"
+  }`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_role.test", "policy_identifiers.#", "0"),
+					resource.TestCheckResourceAttr("vault_pki_secret_backend_role.test", "policy_identifier.#", "2"),
+					testPkiSecretBackendCertHasPolicyIdentifiers("vault_pki_secret_backend_cert.test", "1.2.3.4", "1.2.3.5"),
+				),
+			},
+			{
+				Config: testPkiSecretBackendRoleConfig_policyIdentifiers(backend, "structured", `
+  policy_identifier {
+    oid    = "1.2.3.4"
+    cps    = "https://example.com/cps"
+    notice = "test notice"
+  }
+
+  policy_identifier {
+    oid = "1.2.3.5"
+  }`),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestPkiSecretBackendRoleFlattenPolicyIdentifiers(t *testing.T) {
+	oids, blocks, err := pkiSecretBackendRoleFlattenPolicyIdentifiers([]interface{}{
+		"1.2.3.4",
+		`{"oid":"1.2.3.5","cps":"https://example.com/cps","notice":"test notice"}`,
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if !reflect.DeepEqual(oids, []string{"1.2.3.4"}) {
+		t.Fatalf("unexpected OIDs %v", oids)
+	}
+	expected := []map[string]interface{}{
+		{"oid": "1.2.3.5", "cps": "https://example.com/cps", "notice": "test notice"},
+	}
+	if !reflect.DeepEqual(blocks, expected) {
+		t.Fatalf("expected policy identifier blocks %v, got %v", expected, blocks)
+	}
+
+	if _, _, err := pkiSecretBackendRoleFlattenPolicyIdentifiers([]interface{}{"{oid"}); err == nil {
+		t.Fatal("expected an error for a malformed policy identifier")
+	}
+}
+
+func testPkiSecretBackendCertHasPolicyIdentifiers(n string, oids ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("resource %q not found in state", n)
+		}
+
+		block, _ := pem.Decode([]byte(rs.Primary.Attributes["certificate"]))
+		if block == nil {
+			return fmt.Errorf("certificate of %q is not PEM encoded", n)
+		}
+		cert, err := x509.ParseCertificate(block.Bytes)
+		if err != nil {
+			return err
+		}
+
+		found := make([]string, 0, len(cert.PolicyIdentifiers))
+		for _, oid := range cert.PolicyIdentifiers {
+			found = append(found, oid.String())
+		}
+		if !reflect.DeepEqual(found, oids) {
+			return fmt.Errorf("expected certificate policy identifiers %v, got %v", oids, found)
+		}
+		return nil
+	}
+}
+
+func testPkiSecretBackendRoleConfig_policyIdentifiers(path, certName, policyIdentifiers string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "pki" {
+  path                      = "%s"
+  type                      = "pki"
+  default_lease_ttl_seconds = 3600
+  max_lease_ttl_seconds     = 86400
+}
+
+resource "vault_pki_secret_backend_root_cert" "test" {
+  backend     = vault_mount.pki.path
+  type        = "internal"
+  common_name = "example.com"
+  ttl         = "86400"
+}
+
+resource "vault_pki_secret_backend_role" "test" {
+  depends_on       = [vault_pki_secret_backend_root_cert.test]
+  backend          = vault_mount.pki.path
+  name             = "test"
+  allowed_domains  = ["example.com"]
+  allow_subdomains = true
+  %s
+}
+
+resource "vault_pki_secret_backend_cert" "test" {
+  backend     = vault_mount.pki.path
+  name        = vault_pki_secret_backend_role.test.name
+  common_name = "%s.example.com"
+}
+`, path, policyIdentifiers, certName)
+}
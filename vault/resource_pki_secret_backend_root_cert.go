@@ -4,6 +4,7 @@ import (
 	"fmt"
 	"log"
 	"strings"
+	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
@@ -17,6 +18,10 @@ func pkiSecretBackendRootCertResource() *schema.Resource {
 		Update: pkiSecretBackendRootCertUpdate,
 		Delete: pkiSecretBackendRootCertDelete,
 
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(10 * time.Minute),
+		},
+
 		Schema: map[string]*schema.Schema{
 			"backend": {
 				Type:        schema.TypeString,
@@ -194,7 +199,12 @@ func pkiSecretBackendRootCertResource() *schema.Resource {
 }
 
 func pkiSecretBackendRootCertCreate(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*api.Client)
+	// Generating the key can take longer than a regular request, e.g. for
+	// large RSA keys.
+	client, err := clientWithTimeout(meta.(*api.Client), d.Timeout(schema.TimeoutCreate))
+	if err != nil {
+		return err
+	}
 
 	backend := d.Get("backend").(string)
 	rootType := d.Get("type").(string)
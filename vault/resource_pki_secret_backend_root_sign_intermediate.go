@@ -24,6 +24,12 @@ func pkiSecretBackendRootSignIntermediateResource() *schema.Resource {
 				Description: "The PKI secret backend the resource belongs to.",
 				ForceNew:    true,
 			},
+			"issuer_ref": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "The name or ID of the issuer to sign with. If not set, the default issuer is used. Requires Vault 1.11+.",
+				ForceNew:    true,
+			},
 			"csr": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -170,7 +176,7 @@ func pkiSecretBackendRootSignIntermediateResource() *schema.Resource {
 			"ca_chain": {
 				Type:        schema.TypeString,
 				Computed:    true,
-				Description: "The CA chain.",
+				Description: "The CA chain of the signed certificate, in PEM format.",
 			},
 			"serial": {
 				Type:        schema.TypeString,
@@ -186,7 +192,7 @@ func pkiSecretBackendRootSignIntermediateCreate(d *schema.ResourceData, meta int
 
 	backend := d.Get("backend").(string)
 
-	path := pkiSecretBackendRootSignIntermediateCreatePath(backend)
+	path := pkiSecretBackendRootSignIntermediateCreatePath(backend, d.Get("issuer_ref").(string))
 
 	commonName := d.Get("common_name").(string)
 
@@ -266,7 +272,7 @@ func pkiSecretBackendRootSignIntermediateCreate(d *schema.ResourceData, meta int
 
 	d.Set("certificate", resp.Data["certificate"])
 	d.Set("issuing_ca", resp.Data["issuing_ca"])
-	d.Set("ca_chain", resp.Data["ca_chain"])
+	d.Set("ca_chain", pkiSecretBackendCAChain(resp.Data["ca_chain"]))
 	d.Set("serial", resp.Data["serial_number"])
 
 	d.SetId(fmt.Sprintf("%s/%s", backend, commonName))
@@ -285,6 +291,25 @@ func pkiSecretBackendRootSignIntermediateDelete(d *schema.ResourceData, meta int
 	return nil
 }
 
-func pkiSecretBackendRootSignIntermediateCreatePath(backend string) string {
+func pkiSecretBackendRootSignIntermediateCreatePath(backend, issuerRef string) string {
+	if issuerRef != "" {
+		return strings.Trim(backend, "/") + "/issuer/" + issuerRef + "/sign-intermediate"
+	}
 	return strings.Trim(backend, "/") + "/root/sign-intermediate"
 }
+
+// pkiSecretBackendCAChain returns the CA chain as a single PEM string. Vault
+// returns the chain as a list of certificates, which older releases didn't.
+func pkiSecretBackendCAChain(v interface{}) string {
+	switch chain := v.(type) {
+	case string:
+		return chain
+	case []interface{}:
+		certs := make([]string, 0, len(chain))
+		for _, cert := range chain {
+			certs = append(certs, strings.TrimSpace(cert.(string)))
+		}
+		return strings.Join(certs, "\n")
+	}
+	return ""
+}
@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"regexp"
 	"strings"
 	"testing"
 
@@ -38,6 +39,101 @@ func TestPkiSecretBackendRootSignIntermediate_basic(t *testing.T) {
 	})
 }
 
+func TestPkiSecretBackendRootSignIntermediate_crossSign(t *testing.T) {
+	oldRootPath := acctest.RandomWithPrefix("pki-old-root")
+	newRootPath := acctest.RandomWithPrefix("pki-new-root")
+	resourceName := "vault_pki_secret_backend_root_sign_intermediate.cross_sign"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testPkiSecretBackendRootSignIntermediateDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testPkiSecretBackendRootSignIntermediateConfig_crossSign(oldRootPath, newRootPath),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", oldRootPath),
+					resource.TestCheckResourceAttr(resourceName, "issuer_ref", "old-root"),
+					resource.TestCheckResourceAttrSet(resourceName, "serial"),
+					resource.TestMatchResourceAttr(resourceName, "certificate", regexp.MustCompile("^-----BEGIN CERTIFICATE-----")),
+					resource.TestMatchResourceAttr(resourceName, "ca_chain", regexp.MustCompile("^-----BEGIN CERTIFICATE-----")),
+					resource.TestCheckResourceAttrPair(resourceName, "issuing_ca", "vault_pki_secret_backend_root_cert.old", "certificate"),
+				),
+			},
+		},
+	})
+}
+
+func TestPkiSecretBackendCAChain(t *testing.T) {
+	tests := []struct {
+		chain    interface{}
+		expected string
+	}{
+		{chain: nil, expected: ""},
+		{chain: "a\nb", expected: "a\nb"},
+		{chain: []interface{}{"a\n", "b\n"}, expected: "a\nb"},
+	}
+
+	for _, tt := range tests {
+		if actual := pkiSecretBackendCAChain(tt.chain); actual != tt.expected {
+			t.Errorf("expected %q for %#v, got %q", tt.expected, tt.chain, actual)
+		}
+	}
+}
+
+func testPkiSecretBackendRootSignIntermediateConfig_crossSign(oldRootPath, newRootPath string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "old" {
+  path = "%s"
+  type = "pki"
+}
+
+resource "vault_pki_secret_backend_root_cert" "old" {
+  backend     = vault_mount.old.path
+  type        = "internal"
+  common_name = "test Root CA"
+  ttl         = "86400"
+}
+
+resource "vault_pki_secret_backend_issuer" "old" {
+  backend     = vault_mount.old.path
+  issuer_ref  = "default"
+  issuer_name = "old-root"
+
+  depends_on = [vault_pki_secret_backend_root_cert.old]
+}
+
+resource "vault_mount" "new" {
+  path = "%s"
+  type = "pki"
+}
+
+resource "vault_pki_secret_backend_root_cert" "new" {
+  backend     = vault_mount.new.path
+  type        = "internal"
+  common_name = "test Root CA"
+  ttl         = "86400"
+}
+
+resource "vault_pki_secret_backend_intermediate_cert_request" "cross_sign" {
+  backend     = vault_mount.new.path
+  type        = "existing"
+  key_ref     = "default"
+  common_name = "test Root CA"
+
+  depends_on = [vault_pki_secret_backend_root_cert.new]
+}
+
+resource "vault_pki_secret_backend_root_sign_intermediate" "cross_sign" {
+  backend        = vault_mount.old.path
+  issuer_ref     = vault_pki_secret_backend_issuer.old.issuer_name
+  csr            = vault_pki_secret_backend_intermediate_cert_request.cross_sign.csr
+  common_name    = "test Root CA"
+  use_csr_values = true
+}
+`, oldRootPath, newRootPath)
+}
+
 func testPkiSecretBackendRootSignIntermediateDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -3,9 +3,10 @@ package vault
 import (
 	"fmt"
 	"log"
+	"regexp"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
-	"github.com/hashicorp/vault/api"
 )
 
 func policyResource() *schema.Resource {
@@ -19,6 +20,7 @@ func policyResource() *schema.Resource {
 		},
 
 		Schema: map[string]*schema.Schema{
+			"namespace": namespaceSchema(),
 			"name": {
 				Type:        schema.TypeString,
 				Required:    true,
@@ -31,18 +33,98 @@ func policyResource() *schema.Resource {
 				Required:    true,
 				Description: "The policy document",
 			},
+
+			"validate_templating": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Check the templated parameters of the policy document at plan time",
+			},
 		},
+		CustomizeDiff: policyCustomizeDiff,
+	}
+}
+
+// policyTemplateParameters matches the parameters Vault accepts in templated
+// policies, see https://www.vaultproject.io/docs/concepts/policies#templated-policies
+var policyTemplateParameters = []*regexp.Regexp{
+	regexp.MustCompile(`^identity\.entity\.(id|name)$`),
+	regexp.MustCompile(`^identity\.entity\.metadata\.[^.\s]+$`),
+	regexp.MustCompile(`^identity\.entity\.aliases\.[^.\s]+\.(id|name)$`),
+	regexp.MustCompile(`^identity\.entity\.aliases\.[^.\s]+\.(metadata|custom_metadata)\.[^.\s]+$`),
+	regexp.MustCompile(`^identity\.groups\.ids\.[^.\s]+\.name$`),
+	regexp.MustCompile(`^identity\.groups\.names\.[^.\s]+\.id$`),
+}
+
+// policyCustomizeDiff catches malformed templated parameters before the policy
+// is written, Vault only rejects them once a token tries to use the policy.
+func policyCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.Get("validate_templating").(bool) || !d.NewValueKnown("policy") {
+		return nil
+	}
+
+	if err := validatePolicyTemplating(d.Get("policy").(string)); err != nil {
+		return fmt.Errorf("invalid templating in policy %q: %s", d.Get("name"), err)
+	}
+	return nil
+}
+
+// validatePolicyTemplating does a best-effort check of the {{...}} segments of
+// a policy document without contacting Vault.
+func validatePolicyTemplating(policy string) error {
+	rest := policy
+	for {
+		start := strings.Index(rest, "{{")
+		end := strings.Index(rest, "}}")
+		if start == -1 {
+			if end != -1 {
+				return fmt.Errorf("unexpected %q without an opening %q", "}}", "{{")
+			}
+			return nil
+		}
+		if end == -1 {
+			return fmt.Errorf("unclosed template %q", rest[start:])
+		}
+		if end < start {
+			return fmt.Errorf("unexpected %q without an opening %q", "}}", "{{")
+		}
+
+		inner := rest[start+2 : end]
+		if strings.Contains(inner, "{{") {
+			return fmt.Errorf("unclosed template %q", rest[start:end+2])
+		}
+
+		param := strings.TrimSpace(inner)
+		if param == "" {
+			return fmt.Errorf("empty template %q", rest[start:end+2])
+		}
+
+		known := false
+		for _, re := range policyTemplateParameters {
+			if re.MatchString(param) {
+				known = true
+				break
+			}
+		}
+		if !known {
+			return fmt.Errorf("unknown template parameter %q", param)
+		}
+
+		rest = rest[end+2:]
 	}
 }
 
 func policyWrite(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*api.Client)
+	client, err := namespaceClient(d, meta)
+	if err != nil {
+		return err
+	}
 
 	name := d.Get("name").(string)
 	policy := d.Get("policy").(string)
 
 	log.Printf("[DEBUG] Writing policy %s to Vault", name)
-	err := client.Sys().PutPolicy(name, policy)
+	err = client.Sys().PutPolicy(name, policy)
 
 	if err != nil {
 		return fmt.Errorf("error writing to Vault: %s", err)
@@ -54,13 +136,16 @@ func policyWrite(d *schema.ResourceData, meta interface{}) error {
 }
 
 func policyDelete(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*api.Client)
+	client, err := namespaceClient(d, meta)
+	if err != nil {
+		return err
+	}
 
 	name := d.Id()
 
 	log.Printf("[DEBUG] Deleting policy %s from Vault", name)
 
-	err := client.Sys().DeletePolicy(name)
+	err = client.Sys().DeletePolicy(name)
 	if err != nil {
 		return fmt.Errorf("error deleting from Vault: %s", err)
 	}
@@ -69,7 +154,10 @@ func policyDelete(d *schema.ResourceData, meta interface{}) error {
 }
 
 func policyRead(d *schema.ResourceData, meta interface{}) error {
-	client := meta.(*api.Client)
+	client, err := namespaceClient(d, meta)
+	if err != nil {
+		return err
+	}
 
 	name := d.Id()
 
@@ -2,6 +2,8 @@ package vault
 
 import (
 	"fmt"
+	"os"
+	"regexp"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
@@ -28,6 +30,134 @@ func TestResourcePolicy(t *testing.T) {
 	})
 }
 
+func TestResourcePolicy_validateTemplating(t *testing.T) {
+	name := acctest.RandomWithPrefix("test-")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config:      testResourcePolicy_templatedConfig(name, "identity.entity.metdata.team"),
+				ExpectError: regexp.MustCompile(`unknown template parameter "identity.entity.metdata.team"`),
+			},
+			{
+				Config: testResourcePolicy_templatedConfig(name, "identity.entity.metadata.team"),
+				Check:  resource.TestCheckResourceAttr("vault_policy.test", "validate_templating", "true"),
+			},
+		},
+	})
+}
+
+func TestResourcePolicy_namespace(t *testing.T) {
+	if os.Getenv("TF_ACC_ENTERPRISE") == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+
+	namespace := acctest.RandomWithPrefix("test-namespace")
+	name := acctest.RandomWithPrefix("test-")
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testResourcePolicy_namespaceConfig(namespace, name),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_policy.test", "namespace", namespace),
+					testResourcePolicy_namespaceCheck(namespace, name),
+				),
+			},
+		},
+	})
+}
+
+func testResourcePolicy_namespaceConfig(namespace, name string) string {
+	return fmt.Sprintf(`
+resource "vault_namespace" "test" {
+	path = "%s"
+}
+
+resource "vault_policy" "test" {
+	namespace = vault_namespace.test.path
+	name      = "%s"
+	policy    = <<EOT
+path "secret/*" {
+	policy = "read"
+}
+EOT
+}
+`, namespace, name)
+}
+
+func testResourcePolicy_namespaceCheck(namespace, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client, err := cloneClient(testProvider.Meta().(*api.Client))
+		if err != nil {
+			return err
+		}
+
+		if policy, err := client.Sys().GetPolicy(name); err != nil {
+			return fmt.Errorf("error reading back policy: %s", err)
+		} else if policy != "" {
+			return fmt.Errorf("policy %q unexpectedly found outside namespace %q", name, namespace)
+		}
+
+		client.SetNamespace(namespace)
+		policy, err := client.Sys().GetPolicy(name)
+		if err != nil {
+			return fmt.Errorf("error reading back policy: %s", err)
+		}
+		if policy == "" {
+			return fmt.Errorf("policy %q not found in namespace %q", name, namespace)
+		}
+
+		return nil
+	}
+}
+
+func TestValidatePolicyTemplating(t *testing.T) {
+	tests := map[string]bool{
+		`path "secret/data/{{identity.entity.id}}/*" {}`:                                         true,
+		`path "secret/data/{{ identity.entity.name }}/*" {}`:                                     true,
+		`path "secret/data/{{identity.entity.metadata.team}}/*" {}`:                              true,
+		`path "secret/data/{{identity.entity.aliases.auth_userpass_1234.name}}" {}`:              true,
+		`path "secret/data/{{identity.entity.aliases.auth_userpass_1234.metadata.org}}" {}`:      true,
+		`path "secret/data/{{identity.groups.ids.1234.name}}/{{identity.groups.names.a.id}}" {}`: true,
+		`path "secret/data/*" {}`:                                 true,
+		`path "secret/data/{{identity.entity.id}/*" {}`:           false,
+		`path "secret/data/{{identity.entity.id" {}`:              false,
+		`path "secret/data/identity.entity.id}}" {}`:              false,
+		`path "secret/data/{{}}" {}`:                              false,
+		`path "secret/data/{{identity.entity.metdata.team}}" {}`:  false,
+		`path "secret/data/{{identity.entity.metadata}}" {}`:      false,
+		`path "secret/data/{{identity.groups.ids.1234.id}}" {}`:   false,
+		`path "secret/data/{{identity.entity.id {{identity}}" {}`: false,
+	}
+
+	for policy, valid := range tests {
+		err := validatePolicyTemplating(policy)
+		if valid && err != nil {
+			t.Errorf("expected %q to be valid, got %s", policy, err)
+		}
+		if !valid && err == nil {
+			t.Errorf("expected %q to be invalid", policy)
+		}
+	}
+}
+
+func testResourcePolicy_templatedConfig(name, param string) string {
+	return fmt.Sprintf(`
+resource "vault_policy" "test" {
+	name                = "%s"
+	validate_templating = true
+	policy = <<EOT
+path "secret/data/{{%s}}/*" {
+	capabilities = ["read"]
+}
+EOT
+}
+`, name, param)
+}
+
 func testResourcePolicy_initialConfig(name string) string {
 	return fmt.Sprintf(`
 resource "vault_policy" "test" {
@@ -0,0 +1,96 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+const quotaConfigPath = "sys/quotas/config"
+
+func quotaConfigResource() *schema.Resource {
+	return &schema.Resource{
+		Create: quotaConfigWrite,
+		Read:   quotaConfigRead,
+		Update: quotaConfigWrite,
+		Delete: quotaConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"rate_limit_exempt_paths": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Paths that are exempt from all rate limit quotas.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"enable_rate_limit_audit_logging": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Log requests rejected by rate limit quotas to the audit log.",
+			},
+			"enable_rate_limit_response_headers": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Add the X-Ratelimit response headers to the responses of requests subject to rate limit quotas.",
+			},
+		},
+	}
+}
+
+func quotaConfigWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	data := map[string]interface{}{
+		"rate_limit_exempt_paths":            util.TerraformSetToStringArray(d.Get("rate_limit_exempt_paths")),
+		"enable_rate_limit_audit_logging":    d.Get("enable_rate_limit_audit_logging").(bool),
+		"enable_rate_limit_response_headers": d.Get("enable_rate_limit_response_headers").(bool),
+	}
+
+	log.Printf("[DEBUG] Writing Resource Quota config")
+	if _, err := client.Logical().Write(quotaConfigPath, data); err != nil {
+		return fmt.Errorf("error writing Resource Quota config: %s", err)
+	}
+	log.Printf("[DEBUG] Wrote Resource Quota config")
+
+	d.SetId(quotaConfigPath)
+
+	return quotaConfigRead(d, meta)
+}
+
+func quotaConfigRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	log.Printf("[DEBUG] Reading Resource Quota config")
+	resp, err := client.Logical().Read(quotaConfigPath)
+	if err != nil {
+		return fmt.Errorf("error reading Resource Quota config: %s", err)
+	}
+	log.Printf("[DEBUG] Read Resource Quota config")
+	if resp == nil {
+		log.Printf("[WARN] Resource Quota config not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+
+	if err := d.Set("rate_limit_exempt_paths", resp.Data["rate_limit_exempt_paths"]); err != nil {
+		return fmt.Errorf("error setting rate_limit_exempt_paths for Resource Quota config: %s", err)
+	}
+	d.Set("enable_rate_limit_audit_logging", resp.Data["enable_rate_limit_audit_logging"])
+	d.Set("enable_rate_limit_response_headers", resp.Data["enable_rate_limit_response_headers"])
+
+	return nil
+}
+
+func quotaConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	// The quota config always exists and Vault's defaults depend on its
+	// version, so destroying it only removes it from state.
+	log.Printf("[DEBUG] Removing Resource Quota config from state")
+	return nil
+}
@@ -0,0 +1,78 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestQuotaConfig(t *testing.T) {
+	var original map[string]interface{}
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testAccPreCheck(t)
+
+			// The quota config is cluster-wide, put it back once done.
+			client := testAccClient(t)
+			resp, err := client.Logical().Read(quotaConfigPath)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if resp != nil {
+				original = resp.Data
+			}
+		},
+		CheckDestroy: func(_ *terraform.State) error {
+			if original == nil {
+				return nil
+			}
+			client := testProvider.Meta().(*api.Client)
+			_, err := client.Logical().Write(quotaConfigPath, original)
+			return err
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testQuotaConfig_Config(`["sys/health", "sys/metrics"]`, true, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_quota_config.test", "id", quotaConfigPath),
+					resource.TestCheckResourceAttr("vault_quota_config.test", "rate_limit_exempt_paths.#", "2"),
+					resource.TestCheckResourceAttr("vault_quota_config.test", "enable_rate_limit_audit_logging", "true"),
+					resource.TestCheckResourceAttr("vault_quota_config.test", "enable_rate_limit_response_headers", "false"),
+				),
+			},
+			{
+				Config:   testQuotaConfig_Config(`["sys/metrics", "sys/health"]`, true, false),
+				PlanOnly: true,
+			},
+			{
+				Config: testQuotaConfig_Config(`["sys/health"]`, false, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_quota_config.test", "rate_limit_exempt_paths.#", "1"),
+					resource.TestCheckResourceAttr("vault_quota_config.test", "enable_rate_limit_audit_logging", "false"),
+					resource.TestCheckResourceAttr("vault_quota_config.test", "enable_rate_limit_response_headers", "true"),
+				),
+			},
+			{
+				ResourceName:      "vault_quota_config.test",
+				ImportState:       true,
+				ImportStateId:     quotaConfigPath,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testQuotaConfig_Config(exemptPaths string, auditLogging, responseHeaders bool) string {
+	return fmt.Sprintf(`
+resource "vault_quota_config" "test" {
+  rate_limit_exempt_paths            = %s
+  enable_rate_limit_audit_logging    = %t
+  enable_rate_limit_response_headers = %t
+}
+`, exemptPaths, auditLogging, responseHeaders)
+}
@@ -13,6 +13,23 @@ func quotaLeaseCountPath(name string) string {
 	return "sys/quotas/lease-count/" + name
 }
 
+func quotaLeaseCountData(d *schema.ResourceData, create bool) map[string]interface{} {
+	data := map[string]interface{}{}
+	data["path"] = d.Get("path").(string)
+	data["max_leases"] = d.Get("max_leases").(int)
+
+	// Older Vault versions don't know about role, only send it when used.
+	if create {
+		if v, ok := d.GetOk("role"); ok {
+			data["role"] = v.(string)
+		}
+	} else if d.HasChange("role") {
+		data["role"] = d.Get("role").(string)
+	}
+
+	return data
+}
+
 func quotaLeaseCountResource() *schema.Resource {
 	return &schema.Resource{
 		Create: quotaLeaseCountCreate,
@@ -44,6 +61,11 @@ func quotaLeaseCountResource() *schema.Resource {
 				Description:  "The maximum number of leases to be allowed by the quota rule. The max_leases must be positive.",
 				ValidateFunc: validation.IntAtLeast(0),
 			},
+			"role": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Login role of the auth mount of path to apply the quota to.",
+			},
 		},
 	}
 }
@@ -57,11 +79,7 @@ func quotaLeaseCountCreate(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Creating Resource Lease Count Quota %s", name)
 
-	data := map[string]interface{}{}
-	data["path"] = d.Get("path").(string)
-	data["max_leases"] = d.Get("max_leases").(int)
-
-	_, err := client.Logical().Write(path, data)
+	_, err := client.Logical().Write(path, quotaLeaseCountData(d, true))
 	if err != nil {
 		d.SetId("")
 		return fmt.Errorf("Error creating Resource Lease Count Quota %s: %s", name, err)
@@ -89,7 +107,7 @@ func quotaLeaseCountRead(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
-	for _, k := range []string{"path", "max_leases"} {
+	for _, k := range []string{"path", "max_leases", "role"} {
 		v, ok := resp.Data[k]
 		if ok {
 			if err := d.Set(k, v); err != nil {
@@ -109,11 +127,7 @@ func quotaLeaseCountUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Updating Resource Lease Count Quota %s", name)
 
-	data := map[string]interface{}{}
-	data["path"] = d.Get("path").(string)
-	data["max_leases"] = d.Get("max_leases").(int)
-
-	_, err := client.Logical().Write(path, data)
+	_, err := client.Logical().Write(path, quotaLeaseCountData(d, false))
 	if err != nil {
 		d.SetId("")
 		return fmt.Errorf("Error updating Resource Lease Count Quota %s: %s", name, err)
@@ -9,10 +9,46 @@ import (
 	"github.com/hashicorp/vault/api"
 )
 
+// quotaRateLimitDefaultName is the name of the global rate limit quota that
+// Vault may provide out of the box. It always exists, so it can only be
+// updated and reset, never created or deleted outright.
+const quotaRateLimitDefaultName = "default"
+
+// quotaRateLimitResetFields are the fields of the default quota recorded before
+// Terraform manages it, and written back on destroy.
+var quotaRateLimitResetFields = []string{"rate", "interval", "block_interval"}
+
+func quotaRateLimitIsDefault(name, path string) bool {
+	return name == quotaRateLimitDefaultName && path == ""
+}
+
 func quotaRateLimitPath(name string) string {
 	return "sys/quotas/rate-limit/" + name
 }
 
+func quotaRateLimitData(d *schema.ResourceData, create bool) map[string]interface{} {
+	data := map[string]interface{}{}
+	data["path"] = d.Get("path").(string)
+	data["rate"] = d.Get("rate").(float64)
+
+	if v, ok := d.GetOk("interval"); ok {
+		data["interval"] = v.(int)
+	}
+
+	// Older Vault versions don't know about these, only send them when used.
+	for _, k := range []string{"block_interval", "role"} {
+		if create {
+			if v, ok := d.GetOk(k); ok {
+				data[k] = v
+			}
+		} else if d.HasChange(k) {
+			data[k] = d.Get(k)
+		}
+	}
+
+	return data
+}
+
 func quotaRateLimitResource() *schema.Resource {
 	return &schema.Resource{
 		Create: quotaRateLimitCreate,
@@ -34,7 +70,6 @@ func quotaRateLimitResource() *schema.Resource {
 			"path": {
 				Type:        schema.TypeString,
 				Optional:    true,
-				ForceNew:    true,
 				Description: "Path of the mount or namespace to apply the quota. A blank path configures a global rate limit quota.",
 			},
 			"rate": {
@@ -43,6 +78,32 @@ func quotaRateLimitResource() *schema.Resource {
 				Description:  "The maximum number of requests at any given second to be allowed by the quota rule. The rate must be positive.",
 				ValidateFunc: validation.FloatAtLeast(0.0),
 			},
+			"interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "The duration in seconds to enforce rate limiting for.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"block_interval": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "If set, clients exceeding the rate are blocked for this many seconds.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"role": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Login role of the auth mount of path to apply the quota to.",
+			},
+			"reset_values": {
+				Type:        schema.TypeMap,
+				Computed:    true,
+				Description: "Settings of the built-in default quota before it was managed by Terraform, restored on destroy.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
 		},
 	}
 }
@@ -54,13 +115,33 @@ func quotaRateLimitCreate(d *schema.ResourceData, meta interface{}) error {
 	path := quotaRateLimitPath(name)
 	d.SetId(name)
 
-	log.Printf("[DEBUG] Creating Resource Rate Limit Quota %s", name)
-
-	data := map[string]interface{}{}
-	data["path"] = d.Get("path").(string)
-	data["rate"] = d.Get("rate").(float64)
+	if quotaRateLimitIsDefault(name, d.Get("path").(string)) {
+		// Writes to an existing quota update it in place, so managing the
+		// built-in default quota is the same as updating it. Record its
+		// settings first, to restore them on destroy.
+		log.Printf("[DEBUG] Updating built-in default Resource Rate Limit Quota %s rather than creating it", name)
+		resp, err := client.Logical().Read(path)
+		if err != nil {
+			d.SetId("")
+			return fmt.Errorf("error reading Resource Rate Limit Quota %s: %s", name, err)
+		}
+		if resp != nil {
+			resetValues := map[string]string{}
+			for _, k := range quotaRateLimitResetFields {
+				if v, ok := resp.Data[k]; ok && v != nil {
+					resetValues[k] = fmt.Sprintf("%v", v)
+				}
+			}
+			if err := d.Set("reset_values", resetValues); err != nil {
+				d.SetId("")
+				return fmt.Errorf("error setting reset_values for Resource Rate Limit Quota %s: %s", name, err)
+			}
+		}
+	} else {
+		log.Printf("[DEBUG] Creating Resource Rate Limit Quota %s", name)
+	}
 
-	_, err := client.Logical().Write(path, data)
+	_, err := client.Logical().Write(path, quotaRateLimitData(d, true))
 	if err != nil {
 		d.SetId("")
 		return fmt.Errorf("Error creating Resource Rate Limit Quota %s: %s", name, err)
@@ -88,7 +169,7 @@ func quotaRateLimitRead(d *schema.ResourceData, meta interface{}) error {
 		return nil
 	}
 
-	for _, k := range []string{"path", "rate"} {
+	for _, k := range []string{"path", "rate", "interval", "block_interval", "role"} {
 		v, ok := resp.Data[k]
 		if ok {
 			if err := d.Set(k, v); err != nil {
@@ -108,11 +189,7 @@ func quotaRateLimitUpdate(d *schema.ResourceData, meta interface{}) error {
 
 	log.Printf("[DEBUG] Updating Resource Rate Limit Quota %s", name)
 
-	data := map[string]interface{}{}
-	data["path"] = d.Get("path").(string)
-	data["rate"] = d.Get("rate").(float64)
-
-	_, err := client.Logical().Write(path, data)
+	_, err := client.Logical().Write(path, quotaRateLimitData(d, false))
 	if err != nil {
 		d.SetId("")
 		return fmt.Errorf("Error updating Resource Rate Limit Quota %s: %s", name, err)
@@ -128,10 +205,30 @@ func quotaRateLimitDelete(d *schema.ResourceData, meta interface{}) error {
 	name := d.Id()
 	path := quotaRateLimitPath(name)
 
+	resetValues := d.Get("reset_values").(map[string]interface{})
+	if quotaRateLimitIsDefault(name, d.Get("path").(string)) && len(resetValues) > 0 {
+		// The built-in default quota can't be deleted, so restore the
+		// settings it had before Terraform managed it instead.
+		data := map[string]interface{}{
+			"path": "",
+		}
+		for k, v := range resetValues {
+			data[k] = v
+		}
+
+		log.Printf("[DEBUG] Resetting built-in default Resource Rate Limit Quota %s", name)
+		if _, err := client.Logical().Write(path, data); err != nil {
+			return fmt.Errorf("error resetting Resource Rate Limit Quota %s: %s", name, err)
+		}
+		log.Printf("[DEBUG] Reset built-in default Resource Rate Limit Quota %s", name)
+
+		return nil
+	}
+
 	log.Printf("[DEBUG] Deleting Resource Rate Limit Quota %s", name)
 	_, err := client.Logical().Delete(path)
 	if err != nil {
-		return fmt.Errorf("Error deleting Resource Rate Limit Quota %s", name)
+		return fmt.Errorf("error deleting Resource Rate Limit Quota %s: %s", name, err)
 	}
 	log.Printf("[DEBUG] Deleted Resource Rate Limit Quota %s", name)
 
@@ -53,10 +53,106 @@ func TestQuotaRateLimit(t *testing.T) {
 					resource.TestCheckResourceAttr("vault_quota_rate_limit.foobar", "rate", newRateLimit),
 				),
 			},
+			{
+				Config: testQuotaRateLimit_IntervalConfig(name, "sys/", newRateLimit, 30, 60),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_quota_rate_limit.foobar", "path", "sys/"),
+					resource.TestCheckResourceAttr("vault_quota_rate_limit.foobar", "interval", "30"),
+					resource.TestCheckResourceAttr("vault_quota_rate_limit.foobar", "block_interval", "60"),
+				),
+			},
+			{
+				Config: testQuotaRateLimit_IntervalConfig(name, "sys/", newRateLimit, 30, 0),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_quota_rate_limit.foobar", "interval", "30"),
+					resource.TestCheckResourceAttr("vault_quota_rate_limit.foobar", "block_interval", "0"),
+				),
+			},
+			{
+				ResourceName:      "vault_quota_rate_limit.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestQuotaRateLimit_default(t *testing.T) {
+	rateLimit := randomQuotaRateString()
+	newRateLimit := randomQuotaRateString()
+	var original *api.Secret
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck: func() {
+			testAccPreCheck(t)
+
+			var err error
+			original, err = testAccClient(t).Logical().Read(quotaRateLimitPath(quotaRateLimitDefaultName))
+			if err != nil {
+				t.Fatal(err)
+			}
+		},
+		CheckDestroy: testQuotaRateLimitCheckReset(&original),
+		Steps: []resource.TestStep{
+			{
+				Config: testQuotaRateLimit_Config(quotaRateLimitDefaultName, "", rateLimit),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_quota_rate_limit.foobar", "id", quotaRateLimitDefaultName),
+					resource.TestCheckResourceAttr("vault_quota_rate_limit.foobar", "name", quotaRateLimitDefaultName),
+					resource.TestCheckResourceAttr("vault_quota_rate_limit.foobar", "path", ""),
+					resource.TestCheckResourceAttr("vault_quota_rate_limit.foobar", "rate", rateLimit),
+				),
+			},
+			{
+				Config: testQuotaRateLimit_Config(quotaRateLimitDefaultName, "", newRateLimit),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_quota_rate_limit.foobar", "id", quotaRateLimitDefaultName),
+					resource.TestCheckResourceAttr("vault_quota_rate_limit.foobar", "rate", newRateLimit),
+				),
+			},
+			{
+				ResourceName:            "vault_quota_rate_limit.foobar",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"reset_values"},
+			},
 		},
 	})
 }
 
+// testQuotaRateLimitCheckReset checks destroying the default quota restored
+// the settings it had before the test, or removed it if there was none.
+func testQuotaRateLimitCheckReset(original **api.Secret) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		client := testProvider.Meta().(*api.Client)
+
+		resp, err := client.Logical().Read(quotaRateLimitPath(quotaRateLimitDefaultName))
+		if err != nil {
+			return err
+		}
+
+		if *original == nil {
+			if resp != nil {
+				return fmt.Errorf("Resource Quota Rate Limit %s still exists", quotaRateLimitDefaultName)
+			}
+			return nil
+		}
+
+		if resp == nil {
+			return fmt.Errorf("Resource Quota Rate Limit %s was deleted rather than reset", quotaRateLimitDefaultName)
+		}
+		for _, k := range quotaRateLimitResetFields {
+			expected := fmt.Sprintf("%v", (*original).Data[k])
+			if actual := fmt.Sprintf("%v", resp.Data[k]); actual != expected {
+				return fmt.Errorf("expected %s of Resource Quota Rate Limit %s to be reset to %s, got %s",
+					k, quotaRateLimitDefaultName, expected, actual)
+			}
+		}
+
+		return nil
+	}
+}
+
 func testQuotaRateLimitCheckDestroy(rateLimits []string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		client := testProvider.Meta().(*api.Client)
@@ -87,3 +183,15 @@ resource "vault_quota_rate_limit" "foobar" {
 }
 `, name, path, rate)
 }
+
+func testQuotaRateLimit_IntervalConfig(name, path, rate string, interval, blockInterval int) string {
+	return fmt.Sprintf(`
+resource "vault_quota_rate_limit" "foobar" {
+  name           = "%s"
+  path           = "%s"
+  rate           = %s
+  interval       = %d
+  block_interval = %d
+}
+`, name, path, rate, interval, blockInterval)
+}
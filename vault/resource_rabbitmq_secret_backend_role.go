@@ -4,6 +4,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"log"
+	"sort"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
@@ -69,6 +70,44 @@ func rabbitmqSecretBackendRoleResource() *schema.Resource {
 					},
 				},
 			},
+			"vhost_topic": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				Description: "Specifies a map of virtual hosts to topic exchange permissions.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"host": {
+							Type:        schema.TypeString,
+							Required:    true,
+							Description: "The vhost to set topic permissions for.",
+						},
+						"vhost": {
+							Type:        schema.TypeList,
+							Optional:    true,
+							Description: "The topic exchange permissions for this vhost.",
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"topic": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The topic exchange to set permissions for.",
+									},
+									"read": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The read permissions for this topic exchange.",
+									},
+									"write": {
+										Type:        schema.TypeString,
+										Required:    true,
+										Description: "The write permissions for this topic exchange.",
+									},
+								},
+							},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -111,6 +150,31 @@ func rabbitmqSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) er
 		"tags":   tags,
 		"vhosts": string(vhostsJSON),
 	}
+
+	// vhost_topics is only sent when used, so roles keep working against
+	// Vault versions that don't support topic permissions.
+	vhostTopic := d.Get("vhost_topic").([]interface{})
+	if len(vhostTopic) > 0 || d.HasChange("vhost_topic") {
+		vhostTopics := make(map[string]interface{}, len(vhostTopic))
+		for _, host := range vhostTopic {
+			h := host.(map[string]interface{})
+			topics := map[string]interface{}{}
+			for _, topic := range h["vhost"].([]interface{}) {
+				t := topic.(map[string]interface{})
+				topics[t["topic"].(string)] = map[string]interface{}{
+					"read":  t["read"],
+					"write": t["write"],
+				}
+			}
+			vhostTopics[h["host"].(string)] = topics
+		}
+
+		vhostTopicsJSON, err := json.Marshal(vhostTopics)
+		if err != nil {
+			return fmt.Errorf("error serializing vhost_topics: %s", err)
+		}
+		data["vhost_topics"] = string(vhostTopicsJSON)
+	}
 	log.Printf("[DEBUG] Creating role %q on Rabbitmq backend %q", name, backend)
 	_, err = client.Logical().Write(backend+"/roles/"+name, data)
 	if err != nil {
@@ -146,11 +210,13 @@ func rabbitmqSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) err
 		d.SetId("")
 		return nil
 	}
+
+	// Vault returns the vhosts and topics as maps, so they're kept in the
+	// order they already have in state to avoid spurious diffs.
 	var vhosts []map[string]interface{}
-	if v, ok := secret.Data["vhosts"]; ok && v != nil {
-		hosts := v.(map[string]interface{})
-		for id, val := range hosts {
-			vals := val.(map[string]interface{})
+	if hosts, ok := secret.Data["vhosts"].(map[string]interface{}); ok {
+		for _, id := range rabbitmqSecretBackendRoleOrderKeys(d.Get("vhost").([]interface{}), "host", hosts) {
+			vals := hosts[id].(map[string]interface{})
 			vhosts = append(vhosts, map[string]interface{}{
 				"host":      id,
 				"configure": vals["configure"],
@@ -159,10 +225,40 @@ func rabbitmqSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) err
 			})
 		}
 	}
+
+	var vhostTopics []map[string]interface{}
+	if hosts, ok := secret.Data["vhost_topics"].(map[string]interface{}); ok {
+		current := map[string][]interface{}{}
+		for _, v := range d.Get("vhost_topic").([]interface{}) {
+			h := v.(map[string]interface{})
+			current[h["host"].(string)] = h["vhost"].([]interface{})
+		}
+
+		for _, id := range rabbitmqSecretBackendRoleOrderKeys(d.Get("vhost_topic").([]interface{}), "host", hosts) {
+			topics, _ := hosts[id].(map[string]interface{})
+			var vhost []map[string]interface{}
+			for _, topic := range rabbitmqSecretBackendRoleOrderKeys(current[id], "topic", topics) {
+				vals := topics[topic].(map[string]interface{})
+				vhost = append(vhost, map[string]interface{}{
+					"topic": topic,
+					"write": vals["write"],
+					"read":  vals["read"],
+				})
+			}
+			vhostTopics = append(vhostTopics, map[string]interface{}{
+				"host":  id,
+				"vhost": vhost,
+			})
+		}
+	}
+
 	d.Set("tags", secret.Data["tags"])
 	if err := d.Set("vhost", vhosts); err != nil {
 		return fmt.Errorf("Error setting vhosts in state: %s", err)
 	}
+	if err := d.Set("vhost_topic", vhostTopics); err != nil {
+		return fmt.Errorf("Error setting vhost_topics in state: %s", err)
+	}
 	d.Set("backend", strings.Join(pathPieces[:len(pathPieces)-2], "/"))
 	d.Set("name", pathPieces[len(pathPieces)-1])
 	return nil
@@ -193,3 +289,27 @@ func rabbitmqSecretBackendRoleExists(d *schema.ResourceData, meta interface{}) (
 	log.Printf("[DEBUG] Checked if %q exists", path)
 	return secret != nil, nil
 }
+
+// rabbitmqSecretBackendRoleOrderKeys returns the keys of m, first in the order
+// they appear under key in the current blocks, then sorted.
+func rabbitmqSecretBackendRoleOrderKeys(current []interface{}, key string, m map[string]interface{}) []string {
+	var keys []string
+	seen := map[string]bool{}
+	for _, v := range current {
+		k, _ := v.(map[string]interface{})[key].(string)
+		if _, ok := m[k]; ok && !seen[k] {
+			keys = append(keys, k)
+			seen[k] = true
+		}
+	}
+
+	var rest []string
+	for k := range m {
+		if !seen[k] {
+			rest = append(rest, k)
+		}
+	}
+	sort.Strings(rest)
+
+	return append(keys, rest...)
+}
@@ -2,6 +2,7 @@ package vault
 
 import (
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
@@ -118,6 +119,61 @@ func TestAccRabbitmqSecretBackendRole_nested(t *testing.T) {
 	})
 }
 
+func TestAccRabbitmqSecretBackendRole_multipleVhosts(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-rabbitmq")
+	name := acctest.RandomWithPrefix("tf-test-rabbitmq")
+	connectionUri, username, password := getTestRMQCreds(t)
+	resourceName := "vault_rabbitmq_secret_backend_role.test"
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccRabbitmqSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRabbitmqSecretBackendRoleConfig_multipleVhosts(name, backend, connectionUri, username, password),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "vhost.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "vhost.0.host", "/"),
+					resource.TestCheckResourceAttr(resourceName, "vhost.0.read", ".*"),
+					resource.TestCheckResourceAttr(resourceName, "vhost.1.host", "apps"),
+					resource.TestCheckResourceAttr(resourceName, "vhost.1.configure", "^app\\."),
+					resource.TestCheckResourceAttr(resourceName, "vhost_topic.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "vhost_topic.0.host", "/"),
+					resource.TestCheckResourceAttr(resourceName, "vhost_topic.0.vhost.#", "1"),
+					resource.TestCheckResourceAttr(resourceName, "vhost_topic.0.vhost.0.topic", "amq.topic"),
+					resource.TestCheckResourceAttr(resourceName, "vhost_topic.0.vhost.0.read", ".*"),
+					resource.TestCheckResourceAttr(resourceName, "vhost_topic.1.host", "apps"),
+					resource.TestCheckResourceAttr(resourceName, "vhost_topic.1.vhost.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "vhost_topic.1.vhost.0.topic", "events"),
+					resource.TestCheckResourceAttr(resourceName, "vhost_topic.1.vhost.1.topic", "amq.topic"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Imported blocks come back sorted, not in the configured order.
+				ImportStateVerifyIgnore: []string{"vhost_topic"},
+			},
+		},
+	})
+}
+
+func TestRabbitmqSecretBackendRoleOrderKeys(t *testing.T) {
+	current := []interface{}{
+		map[string]interface{}{"host": "b"},
+		map[string]interface{}{"host": "gone"},
+		map[string]interface{}{"host": "a"},
+	}
+	m := map[string]interface{}{"a": nil, "b": nil, "d": nil, "c": nil}
+
+	keys := rabbitmqSecretBackendRoleOrderKeys(current, "host", m)
+	expected := []string{"b", "a", "c", "d"}
+	if !reflect.DeepEqual(keys, expected) {
+		t.Errorf("expected %v, got %v", expected, keys)
+	}
+}
+
 func testAccRabbitmqSecretBackendRoleCheckDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -187,3 +243,56 @@ resource "vault_rabbitmq_secret_backend_role" "test" {
 }
 `, path, connectionUri, username, password, name, testAccRabbitmqSecretBackendRoleTags_updated)
 }
+
+func testAccRabbitmqSecretBackendRoleConfig_multipleVhosts(name, path, connectionUri, username, password string) string {
+	return fmt.Sprintf(`
+resource "vault_rabbitmq_secret_backend" "test" {
+  path = "%s"
+  description = "test description"
+  default_lease_ttl_seconds = 3600
+  max_lease_ttl_seconds = 86400
+  connection_uri = "%s"
+  username = "%s"
+  password = "%s"
+}
+
+resource "vault_rabbitmq_secret_backend_role" "test" {
+  backend = "${vault_rabbitmq_secret_backend.test.path}"
+  name = "%s"
+  tags = "management"
+  vhost {
+    host = "/"
+    configure = ""
+    read = ".*"
+    write = ""
+  }
+  vhost {
+    host = "apps"
+    configure = "^app\\."
+    read = "^app\\."
+    write = "^app\\."
+  }
+  vhost_topic {
+    host = "/"
+    vhost {
+      topic = "amq.topic"
+      read = ".*"
+      write = ""
+    }
+  }
+  vhost_topic {
+    host = "apps"
+    vhost {
+      topic = "events"
+      read = ".*"
+      write = ".*"
+    }
+    vhost {
+      topic = "amq.topic"
+      read = ".*"
+      write = ""
+    }
+  }
+}
+`, path, connectionUri, username, password, name)
+}
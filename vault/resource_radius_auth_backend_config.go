@@ -0,0 +1,227 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	radiusAuthBackendConfigFromPathRegex = regexp.MustCompile("^auth/(.+)/config$")
+)
+
+func radiusAuthBackendConfigResource() *schema.Resource {
+	fields := map[string]*schema.Schema{
+		"backend": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "Path of the RADIUS auth backend to configure.",
+			ForceNew:    true,
+			Default:     "radius",
+			// standardise on no beginning or trailing slashes
+			StateFunc: func(v interface{}) string {
+				return strings.Trim(v.(string), "/")
+			},
+		},
+		"host": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Description: "The RADIUS server to connect to.",
+		},
+		"port": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Default:      1812,
+			Description:  "The UDP port the RADIUS server listens on.",
+			ValidateFunc: validation.IntBetween(1, 65535),
+		},
+		"secret": {
+			Type:        schema.TypeString,
+			Required:    true,
+			Sensitive:   true,
+			Description: "The RADIUS shared secret.",
+		},
+		"unregistered_user_policies": {
+			Type:        schema.TypeSet,
+			Optional:    true,
+			Description: "Policies granted to users authenticated by the RADIUS server but not registered in the backend.",
+			Elem: &schema.Schema{
+				Type: schema.TypeString,
+			},
+		},
+		"dial_timeout": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Number of seconds to wait for a connection to the RADIUS server.",
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"read_timeout": {
+			Type:         schema.TypeInt,
+			Optional:     true,
+			Computed:     true,
+			Description:  "Number of seconds to wait for a response from the RADIUS server.",
+			ValidateFunc: validation.IntAtLeast(1),
+		},
+		"nas_port": {
+			Type:        schema.TypeInt,
+			Optional:    true,
+			Computed:    true,
+			Description: "The NAS-Port attribute of the RADIUS request.",
+		},
+		"nas_identifier": {
+			Type:        schema.TypeString,
+			Optional:    true,
+			Description: "The NAS-Identifier attribute of the RADIUS request.",
+		},
+	}
+
+	addTokenFields(fields, &addTokenFieldsConfig{})
+
+	return &schema.Resource{
+		Create: radiusAuthBackendConfigCreate,
+		Read:   radiusAuthBackendConfigRead,
+		Update: radiusAuthBackendConfigUpdate,
+		Delete: radiusAuthBackendConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: fields,
+	}
+}
+
+func radiusAuthBackendConfigPath(backend string) string {
+	return "auth/" + strings.Trim(backend, "/") + "/config"
+}
+
+func radiusAuthBackendConfigBackendFromPath(path string) (string, error) {
+	if !radiusAuthBackendConfigFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := radiusAuthBackendConfigFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
+
+func radiusAuthBackendConfigData(d *schema.ResourceData, create bool) map[string]interface{} {
+	data := map[string]interface{}{
+		"host":   d.Get("host").(string),
+		"port":   d.Get("port").(int),
+		"secret": d.Get("secret").(string),
+		// Vault only replaces the policies when they're given, so always send
+		// them to allow removing all of them.
+		"unregistered_user_policies": strings.Join(util.TerraformSetToStringArray(d.Get("unregistered_user_policies")), ","),
+	}
+
+	for _, k := range []string{"dial_timeout", "read_timeout", "nas_port"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v.(int)
+		}
+	}
+
+	if create {
+		if v, ok := d.GetOk("nas_identifier"); ok {
+			data["nas_identifier"] = v.(string)
+		}
+	} else if d.HasChange("nas_identifier") {
+		data["nas_identifier"] = d.Get("nas_identifier").(string)
+	}
+
+	updateTokenFields(d, data, create)
+
+	return data
+}
+
+func radiusAuthBackendConfigCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := radiusAuthBackendConfigPath(d.Get("backend").(string))
+
+	log.Printf("[DEBUG] Writing RADIUS auth backend config %q", path)
+	if _, err := client.Logical().Write(path, radiusAuthBackendConfigData(d, true)); err != nil {
+		return fmt.Errorf("error writing RADIUS auth backend config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote RADIUS auth backend config %q", path)
+
+	d.SetId(path)
+
+	return radiusAuthBackendConfigRead(d, meta)
+}
+
+func radiusAuthBackendConfigRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	backend, err := radiusAuthBackendConfigBackendFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q for RADIUS auth backend config: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading RADIUS auth backend config %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading RADIUS auth backend config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read RADIUS auth backend config %q", path)
+	if resp == nil {
+		log.Printf("[WARN] RADIUS auth backend config %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	if err := readTokenFields(d, resp); err != nil {
+		return err
+	}
+
+	d.Set("backend", backend)
+	d.Set("host", resp.Data["host"])
+	d.Set("nas_identifier", resp.Data["nas_identifier"])
+
+	for _, k := range []string{"port", "dial_timeout", "read_timeout", "nas_port"} {
+		if v, ok := resp.Data[k].(json.Number); ok {
+			n, err := v.Int64()
+			if err != nil {
+				return fmt.Errorf("expected %s of %q to be a number, got %q", k, path, v)
+			}
+			d.Set(k, n)
+		}
+	}
+
+	if err := d.Set("unregistered_user_policies", resp.Data["unregistered_user_policies"]); err != nil {
+		return fmt.Errorf("error setting unregistered_user_policies for %q: %s", path, err)
+	}
+
+	// `secret` cannot be read out from the API, so it keeps the configured value.
+
+	return nil
+}
+
+func radiusAuthBackendConfigUpdate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	log.Printf("[DEBUG] Updating RADIUS auth backend config %q", path)
+	if _, err := client.Logical().Write(path, radiusAuthBackendConfigData(d, false)); err != nil {
+		return fmt.Errorf("error updating RADIUS auth backend config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Updated RADIUS auth backend config %q", path)
+
+	return radiusAuthBackendConfigRead(d, meta)
+}
+
+func radiusAuthBackendConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	// The RADIUS auth backend config can't be deleted, it's removed along
+	// with the backend.
+	log.Printf("[DEBUG] Removing RADIUS auth backend config %q from state", d.Id())
+	return nil
+}
@@ -0,0 +1,79 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestAccRadiusAuthBackendConfig_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-radius")
+	resourceName := "vault_radius_auth_backend_config.config"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRadiusAuthBackendConfig(backend, `
+  unregistered_user_policies = ["default", "dev"]
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "auth/"+backend+"/config"),
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "host", "radius.example.com"),
+					resource.TestCheckResourceAttr(resourceName, "port", "1812"),
+					resource.TestCheckResourceAttr(resourceName, "unregistered_user_policies.#", "2"),
+					resource.TestCheckResourceAttr(resourceName, "dial_timeout", "10"),
+				),
+			},
+			{
+				Config: testAccRadiusAuthBackendConfig(backend, `
+  port                       = 1645
+  dial_timeout               = 5
+  read_timeout               = 5
+  nas_identifier             = "vault"
+  token_policies             = ["default"]
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "port", "1645"),
+					resource.TestCheckResourceAttr(resourceName, "unregistered_user_policies.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "dial_timeout", "5"),
+					resource.TestCheckResourceAttr(resourceName, "read_timeout", "5"),
+					resource.TestCheckResourceAttr(resourceName, "nas_identifier", "vault"),
+					resource.TestCheckResourceAttr(resourceName, "token_policies.#", "1"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"secret"},
+			},
+			{
+				Config: testAccRadiusAuthBackendConfig(backend, `
+  port = 70000
+`),
+				ExpectError: regexp.MustCompile(`expected port to be in the range \(1 - 65535\)`),
+			},
+		},
+	})
+}
+
+func testAccRadiusAuthBackendConfig(backend, extra string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "radius" {
+  type = "radius"
+  path = "%s"
+}
+
+resource "vault_radius_auth_backend_config" "config" {
+  backend = vault_auth_backend.radius.path
+  host    = "radius.example.com"
+  secret  = "super-secret"
+%s}
+`, backend, extra)
+}
@@ -0,0 +1,151 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+var (
+	radiusAuthBackendUserBackendFromPathRegex = regexp.MustCompile("^auth/(.+)/users/.+$")
+	radiusAuthBackendUserNameFromPathRegex    = regexp.MustCompile("^auth/.+/users/(.+)$")
+)
+
+func radiusAuthBackendUserResource() *schema.Resource {
+	return &schema.Resource{
+		Create: radiusAuthBackendUserWrite,
+		Update: radiusAuthBackendUserWrite,
+		Read:   radiusAuthBackendUserRead,
+		Delete: radiusAuthBackendUserDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "radius",
+				Description: "Path of the RADIUS auth backend the user belongs to.",
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the RADIUS user.",
+				ValidateFunc: validateNoTrailingSlash,
+			},
+			"policies": {
+				Type:        schema.TypeSet,
+				Optional:    true,
+				Description: "Policies granted to the user when logging in.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+		},
+	}
+}
+
+func radiusAuthBackendUserPath(backend, name string) string {
+	return "auth/" + strings.Trim(backend, "/") + "/users/" + strings.Trim(name, "/")
+}
+
+func radiusAuthBackendUserWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := radiusAuthBackendUserPath(d.Get("backend").(string), d.Get("name").(string))
+
+	data := map[string]interface{}{
+		"policies": strings.Join(util.TerraformSetToStringArray(d.Get("policies")), ","),
+	}
+
+	log.Printf("[DEBUG] Writing RADIUS user %q", path)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing RADIUS user %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Wrote RADIUS user %q", path)
+
+	d.SetId(path)
+
+	return radiusAuthBackendUserRead(d, meta)
+}
+
+func radiusAuthBackendUserRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	backend, err := radiusAuthBackendUserBackendFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q for RADIUS auth backend user: %s", path, err)
+	}
+
+	name, err := radiusAuthBackendUserNameFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q for RADIUS auth backend user: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading RADIUS user %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading RADIUS user %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Read RADIUS user %q", path)
+	if resp == nil {
+		log.Printf("[WARN] RADIUS user %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("backend", backend)
+	d.Set("name", name)
+	if err := d.Set("policies", resp.Data["policies"]); err != nil {
+		return fmt.Errorf("error setting policies for RADIUS user %q: %s", path, err)
+	}
+
+	return nil
+}
+
+func radiusAuthBackendUserDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting RADIUS user %q", path)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return fmt.Errorf("error deleting RADIUS user %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted RADIUS user %q", path)
+
+	return nil
+}
+
+func radiusAuthBackendUserNameFromPath(path string) (string, error) {
+	if !radiusAuthBackendUserNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no user found")
+	}
+	res := radiusAuthBackendUserNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for user", len(res))
+	}
+	return res[1], nil
+}
+
+func radiusAuthBackendUserBackendFromPath(path string) (string, error) {
+	if !radiusAuthBackendUserBackendFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no backend found")
+	}
+	res := radiusAuthBackendUserBackendFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for backend", len(res))
+	}
+	return res[1], nil
+}
@@ -0,0 +1,108 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccRadiusAuthBackendUser_basic(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-radius")
+	resourceName := "vault_radius_auth_backend_user.user"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccRadiusAuthBackendUserCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRadiusAuthBackendUserConfig(backend, `["default", "dev"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", "auth/"+backend+"/users/alice"),
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "name", "alice"),
+					resource.TestCheckResourceAttr(resourceName, "policies.#", "2"),
+				),
+			},
+			{
+				Config: testAccRadiusAuthBackendUserConfig(backend, `["prod"]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "policies.#", "1"),
+				),
+			},
+			{
+				Config: testAccRadiusAuthBackendUserConfig(backend, `[]`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "policies.#", "0"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestRadiusAuthBackendUserFromPath(t *testing.T) {
+	path := "auth/nested/radius/users/alice"
+
+	backend, err := radiusAuthBackendUserBackendFromPath(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if backend != "nested/radius" {
+		t.Errorf("expected backend %q, got %q", "nested/radius", backend)
+	}
+
+	name, err := radiusAuthBackendUserNameFromPath(path)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "alice" {
+		t.Errorf("expected name %q, got %q", "alice", name)
+	}
+
+	if _, err := radiusAuthBackendUserNameFromPath("auth/radius/config"); err == nil {
+		t.Error("expected an error parsing a path without a user")
+	}
+}
+
+func testAccRadiusAuthBackendUserCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_radius_auth_backend_user" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			// The backend is gone along with its users.
+			continue
+		}
+		if secret != nil {
+			return fmt.Errorf("RADIUS user %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccRadiusAuthBackendUserConfig(backend, policies string) string {
+	return fmt.Sprintf(`
+resource "vault_auth_backend" "radius" {
+  type = "radius"
+  path = "%s"
+}
+
+resource "vault_radius_auth_backend_user" "user" {
+  backend  = vault_auth_backend.radius.path
+  name     = "alice"
+  policies = %s
+}
+`, backend, policies)
+}
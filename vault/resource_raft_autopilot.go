@@ -0,0 +1,165 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	"github.com/hashicorp/vault/api"
+)
+
+const raftAutopilotPath = "sys/storage/raft/autopilot/configuration"
+
+var (
+	raftAutopilotDurationFields = []string{
+		"dead_server_last_contact_threshold",
+		"last_contact_threshold",
+		"server_stabilization_time",
+	}
+	raftAutopilotIntFields = []string{
+		"max_trailing_logs",
+		"min_quorum",
+	}
+)
+
+func raftAutopilotResource() *schema.Resource {
+	return &schema.Resource{
+		Create: raftAutopilotWrite,
+		Read:   raftAutopilotRead,
+		Update: raftAutopilotWrite,
+		Delete: raftAutopilotDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"cleanup_dead_servers": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "Remove dead servers from the Raft peer list periodically.",
+			},
+			"dead_server_last_contact_threshold": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "24h",
+				Description:      "Time after which a server that hasn't contacted the leader is considered dead and can be removed.",
+				ValidateFunc:     validateDurationSeconds,
+				DiffSuppressFunc: util.DurationSecondsDiffSuppress,
+			},
+			"last_contact_threshold": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "10s",
+				Description:      "Time after which a server that hasn't contacted the leader is considered unhealthy.",
+				ValidateFunc:     validateDurationSeconds,
+				DiffSuppressFunc: util.DurationSecondsDiffSuppress,
+			},
+			"max_trailing_logs": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1000,
+				Description:  "Maximum number of log entries a server can trail the leader by before being considered unhealthy.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"min_quorum": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Minimum number of servers in the cluster before dead servers can be removed. Must be at least 3 when cleanup_dead_servers is set.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"server_stabilization_time": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Default:          "10s",
+				Description:      "Time a server must be healthy for before being promoted to a voter.",
+				ValidateFunc:     validateDurationSeconds,
+				DiffSuppressFunc: util.DurationSecondsDiffSuppress,
+			},
+		},
+		CustomizeDiff: raftAutopilotCustomizeDiff,
+	}
+}
+
+// raftAutopilotCustomizeDiff catches a min_quorum Vault would reject when
+// dead server cleanup is enabled.
+func raftAutopilotCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.Get("cleanup_dead_servers").(bool) || !d.NewValueKnown("min_quorum") {
+		return nil
+	}
+
+	if minQuorum := d.Get("min_quorum").(int); minQuorum < 3 {
+		return fmt.Errorf("min_quorum must be at least 3 when cleanup_dead_servers is true, got %d", minQuorum)
+	}
+	return nil
+}
+
+func raftAutopilotWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	data := map[string]interface{}{
+		"cleanup_dead_servers": d.Get("cleanup_dead_servers").(bool),
+		"max_trailing_logs":    d.Get("max_trailing_logs").(int),
+	}
+	for _, k := range raftAutopilotDurationFields {
+		data[k] = d.Get(k).(string)
+	}
+	if v, ok := d.GetOk("min_quorum"); ok {
+		data["min_quorum"] = v.(int)
+	}
+
+	log.Printf("[DEBUG] Writing Raft autopilot configuration")
+	if _, err := client.Logical().Write(raftAutopilotPath, data); err != nil {
+		return fmt.Errorf("error writing Raft autopilot configuration: %s", err)
+	}
+	log.Printf("[DEBUG] Wrote Raft autopilot configuration")
+
+	d.SetId(raftAutopilotPath)
+
+	return raftAutopilotRead(d, meta)
+}
+
+func raftAutopilotRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	log.Printf("[DEBUG] Reading Raft autopilot configuration")
+	resp, err := client.Logical().Read(raftAutopilotPath)
+	if err != nil {
+		return fmt.Errorf("error reading Raft autopilot configuration: %s", err)
+	}
+	log.Printf("[DEBUG] Read Raft autopilot configuration")
+	if resp == nil {
+		log.Printf("[WARN] Raft autopilot configuration not found, removing from state")
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("cleanup_dead_servers", resp.Data["cleanup_dead_servers"])
+
+	for _, k := range raftAutopilotDurationFields {
+		d.Set(k, resp.Data[k])
+	}
+
+	for _, k := range raftAutopilotIntFields {
+		if v, ok := resp.Data[k].(json.Number); ok {
+			n, err := v.Int64()
+			if err != nil {
+				return fmt.Errorf("expected %s %q to be a number, and it isn't", k, v)
+			}
+			d.Set(k, n)
+		}
+	}
+
+	return nil
+}
+
+func raftAutopilotDelete(d *schema.ResourceData, meta interface{}) error {
+	// The autopilot configuration always exists, so destroying it only
+	// removes it from state.
+	log.Printf("[DEBUG] Removing Raft autopilot configuration from state")
+	return nil
+}
@@ -0,0 +1,80 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+// testAccPreCheckRaft skips tests that need Vault to use integrated storage.
+func testAccPreCheckRaft(t *testing.T) {
+	testAccPreCheck(t)
+
+	client := testAccClient(t)
+	if _, err := client.Logical().Read("sys/storage/raft/configuration"); err != nil {
+		t.Skipf("Vault isn't using integrated storage: %s", err)
+	}
+}
+
+func TestAccRaftAutopilot(t *testing.T) {
+	resourceName := "vault_raft_autopilot.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheckRaft(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRaftAutopilotConfig(false, 0, "10s"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", raftAutopilotPath),
+					resource.TestCheckResourceAttr(resourceName, "cleanup_dead_servers", "false"),
+					resource.TestCheckResourceAttr(resourceName, "dead_server_last_contact_threshold", "24h0m0s"),
+					resource.TestCheckResourceAttr(resourceName, "last_contact_threshold", "10s"),
+					resource.TestCheckResourceAttr(resourceName, "max_trailing_logs", "1000"),
+					resource.TestCheckResourceAttr(resourceName, "server_stabilization_time", "10s"),
+				),
+			},
+			{
+				Config: testAccRaftAutopilotConfig(true, 3, "30s"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "cleanup_dead_servers", "true"),
+					resource.TestCheckResourceAttr(resourceName, "min_quorum", "3"),
+					resource.TestCheckResourceAttr(resourceName, "server_stabilization_time", "30s"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config:      testAccRaftAutopilotConfig(true, 1, "30s"),
+				ExpectError: regexp.MustCompile("min_quorum must be at least 3 when cleanup_dead_servers is true"),
+			},
+			{
+				Config:      testAccRaftAutopilotConfig(false, 0, "soon"),
+				ExpectError: regexp.MustCompile("expected server_stabilization_time to be a number of seconds or a duration string"),
+			},
+			{
+				// Restore the defaults.
+				Config: testAccRaftAutopilotConfig(false, 0, "10s"),
+			},
+		},
+	})
+}
+
+func testAccRaftAutopilotConfig(cleanupDeadServers bool, minQuorum int, stabilizationTime string) string {
+	quorum := ""
+	if minQuorum > 0 {
+		quorum = fmt.Sprintf("\n  min_quorum                = %d", minQuorum)
+	}
+
+	return fmt.Sprintf(`
+resource "vault_raft_autopilot" "test" {
+  cleanup_dead_servers      = %t
+  server_stabilization_time = "%s"%s
+}
+`, cleanupDeadServers, stabilizationTime, quorum)
+}
@@ -0,0 +1,138 @@
+package vault
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"log"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func raftSnapshotResource() *schema.Resource {
+	return &schema.Resource{
+		Create: raftSnapshotCreate,
+		Read:   raftSnapshotRead,
+		Delete: raftSnapshotDelete,
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(20 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"path": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Local file the snapshot is written to.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary map of values that, when changed, will take the snapshot again.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"sha256": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Hex encoded SHA-256 checksum of the snapshot.",
+			},
+			"size": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Size of the snapshot in bytes.",
+			},
+		},
+	}
+}
+
+// raftSnapshotCounter counts the bytes written through it, and fails writes
+// past the deadline.
+type raftSnapshotCounter struct {
+	n        int64
+	deadline time.Time
+}
+
+func (c *raftSnapshotCounter) Write(p []byte) (int, error) {
+	if time.Now().After(c.deadline) {
+		return 0, fmt.Errorf("timed out after %d bytes", c.n)
+	}
+	c.n += int64(len(p))
+	return len(p), nil
+}
+
+func raftSnapshotCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Get("path").(string)
+
+	// The snapshot is streamed to a temporary file next to path, and only
+	// renamed to it once complete, so path never holds a partial snapshot.
+	tmp, err := ioutil.TempFile(filepath.Dir(path), "."+filepath.Base(path)+".tmp")
+	if err != nil {
+		return fmt.Errorf("error creating temporary file for Raft snapshot %q: %s", path, err)
+	}
+	defer os.Remove(tmp.Name())
+	defer tmp.Close()
+
+	hash := sha256.New()
+	// The snapshot is read without the client's request timeout, so the
+	// timeout of the operation is enforced while streaming it instead.
+	counter := &raftSnapshotCounter{
+		deadline: time.Now().Add(d.Timeout(schema.TimeoutCreate)),
+	}
+
+	log.Printf("[DEBUG] Taking Raft snapshot to %q", path)
+	if err := client.Sys().RaftSnapshot(io.MultiWriter(tmp, hash, counter)); err != nil {
+		return fmt.Errorf("error taking Raft snapshot to %q: %s", path, err)
+	}
+	if err := tmp.Sync(); err != nil {
+		return fmt.Errorf("error writing Raft snapshot to %q: %s", path, err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("error writing Raft snapshot to %q: %s", path, err)
+	}
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("error writing Raft snapshot to %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Took Raft snapshot to %q", path)
+
+	d.SetId(path)
+	d.Set("sha256", hex.EncodeToString(hash.Sum(nil)))
+	d.Set("size", counter.n)
+
+	return raftSnapshotRead(d, meta)
+}
+
+func raftSnapshotRead(d *schema.ResourceData, meta interface{}) error {
+	path := d.Id()
+
+	// Only check the file is still there, hashing it again on every refresh
+	// would be costly for large snapshots.
+	if _, err := os.Stat(path); err != nil {
+		if os.IsNotExist(err) {
+			log.Printf("[WARN] Raft snapshot %q not found, removing from state", path)
+			d.SetId("")
+			return nil
+		}
+		return fmt.Errorf("error reading Raft snapshot %q: %s", path, err)
+	}
+
+	return nil
+}
+
+func raftSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
+	// The snapshot is a backup, so destroying the resource leaves the file
+	// in place.
+	log.Printf("[DEBUG] Removing Raft snapshot %q from state; the file is left as it is", d.Id())
+	return nil
+}
@@ -0,0 +1,376 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+var raftSnapshotAgentConfigNameFromPathRegex = regexp.MustCompile("^sys/storage/raft/snapshot-auto/config/(.+)$")
+
+// raftSnapshotAgentConfigStorageFields are the options of each storage type,
+// the first ones listed are required.
+var raftSnapshotAgentConfigStorageFields = map[string]struct {
+	required []string
+	optional []string
+}{
+	"local": {
+		optional: []string{"local_max_space"},
+	},
+	"aws-s3": {
+		required: []string{"aws_s3_bucket", "aws_s3_region"},
+		optional: []string{
+			"aws_access_key_id", "aws_secret_access_key", "aws_session_token", "aws_s3_endpoint",
+			"aws_s3_disable_tls", "aws_s3_force_path_style", "aws_s3_enable_kms", "aws_s3_server_side_encryption",
+			"aws_s3_kms_key",
+		},
+	},
+	"google-gcs": {
+		required: []string{"google_gcs_bucket"},
+		optional: []string{"google_service_account_key", "google_endpoint", "google_disable_tls"},
+	},
+	"azure-blob": {
+		required: []string{"azure_container_name", "azure_account_name"},
+		optional: []string{"azure_account_key", "azure_blob_environment", "azure_endpoint"},
+	},
+}
+
+// raftSnapshotAgentConfigSensitiveFields aren't returned by Vault, so they
+// keep their configured values.
+var raftSnapshotAgentConfigSensitiveFields = []string{
+	"aws_secret_access_key",
+	"aws_session_token",
+	"google_service_account_key",
+	"azure_account_key",
+}
+
+func raftSnapshotAgentConfigResource() *schema.Resource {
+	return &schema.Resource{
+		Create: raftSnapshotAgentConfigWrite,
+		Read:   raftSnapshotAgentConfigRead,
+		Update: raftSnapshotAgentConfigWrite,
+		Delete: raftSnapshotAgentConfigDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the automated snapshot configuration.",
+				ValidateFunc: validateNoTrailingSlash,
+			},
+			"interval_seconds": {
+				Type:         schema.TypeInt,
+				Required:     true,
+				Description:  "Number of seconds between snapshots.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"retain": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Default:      1,
+				Description:  "Number of snapshots to keep.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"path_prefix": {
+				Type:        schema.TypeString,
+				Required:    true,
+				Description: "Directory or bucket prefix the snapshots are written to.",
+			},
+			"file_prefix": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Default:     "vault-snapshot",
+				Description: "Prefix of the snapshot file names.",
+			},
+			"storage_type": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Where the snapshots are stored, one of local, aws-s3, google-gcs or azure-blob.",
+				ValidateFunc: validation.StringInSlice([]string{"local", "aws-s3", "google-gcs", "azure-blob"}, false),
+			},
+			"local_max_space": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Description:  "Maximum space in bytes the local snapshots may use, 0 means unlimited.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"aws_s3_bucket": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "S3 bucket to write the snapshots to.",
+			},
+			"aws_s3_region": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "AWS region of the bucket.",
+			},
+			"aws_access_key_id": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "AWS access key ID, the default credential chain is used when unset.",
+			},
+			"aws_secret_access_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "AWS secret access key.",
+			},
+			"aws_session_token": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "AWS session token.",
+			},
+			"aws_s3_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "S3 endpoint to use instead of AWS.",
+			},
+			"aws_s3_disable_tls": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Disable TLS for the S3 endpoint.",
+			},
+			"aws_s3_force_path_style": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Use path-style S3 requests.",
+			},
+			"aws_s3_enable_kms": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Encrypt the snapshots with KMS.",
+			},
+			"aws_s3_server_side_encryption": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Encrypt the snapshots with S3 managed keys.",
+			},
+			"aws_s3_kms_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "KMS key to encrypt the snapshots with.",
+			},
+			"google_gcs_bucket": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "GCS bucket to write the snapshots to.",
+			},
+			"google_service_account_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Google service account key in JSON format, the application default credentials are used when unset.",
+			},
+			"google_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "GCS endpoint to use instead of Google's.",
+			},
+			"google_disable_tls": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Description: "Disable TLS for the GCS endpoint.",
+			},
+			"azure_container_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Azure container to write the snapshots to.",
+			},
+			"azure_account_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Azure storage account name.",
+			},
+			"azure_account_key": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Sensitive:   true,
+				Description: "Azure storage account key.",
+			},
+			"azure_blob_environment": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Azure blob environment.",
+			},
+			"azure_endpoint": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Azure blob endpoint to use instead of Azure's.",
+			},
+		},
+		CustomizeDiff: raftSnapshotAgentConfigCustomizeDiff,
+	}
+}
+
+// raftSnapshotAgentConfigCustomizeDiff checks the storage options match the
+// storage type.
+func raftSnapshotAgentConfigCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("storage_type") {
+		return nil
+	}
+	storageType := d.Get("storage_type").(string)
+
+	for _, k := range raftSnapshotAgentConfigStorageFields[storageType].required {
+		if !d.NewValueKnown(k) {
+			continue
+		}
+		if _, ok := d.GetOk(k); !ok {
+			return fmt.Errorf("%s is required when storage_type is %q", k, storageType)
+		}
+	}
+
+	for otherType, fields := range raftSnapshotAgentConfigStorageFields {
+		if otherType == storageType {
+			continue
+		}
+		for _, k := range append(fields.required, fields.optional...) {
+			if _, ok := d.GetOk(k); ok {
+				return fmt.Errorf("%s can only be set when storage_type is %q", k, otherType)
+			}
+		}
+	}
+
+	return nil
+}
+
+func raftSnapshotAgentConfigPath(name string) string {
+	return "sys/storage/raft/snapshot-auto/config/" + name
+}
+
+func raftSnapshotAgentConfigWrite(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	name := d.Get("name").(string)
+	path := raftSnapshotAgentConfigPath(name)
+	storageType := d.Get("storage_type").(string)
+
+	data := map[string]interface{}{
+		"interval":     d.Get("interval_seconds").(int),
+		"retain":       d.Get("retain").(int),
+		"path_prefix":  d.Get("path_prefix").(string),
+		"file_prefix":  d.Get("file_prefix").(string),
+		"storage_type": storageType,
+	}
+
+	// Vault replaces the whole config, so send all the options of the
+	// storage type.
+	fields := raftSnapshotAgentConfigStorageFields[storageType]
+	for _, k := range append(fields.required, fields.optional...) {
+		data[k] = d.Get(k)
+	}
+
+	log.Printf("[DEBUG] Writing Raft automated snapshot config %q", name)
+	if _, err := client.Logical().Write(path, data); err != nil {
+		return fmt.Errorf("error writing Raft automated snapshot config %q: %s", name, err)
+	}
+	log.Printf("[DEBUG] Wrote Raft automated snapshot config %q", name)
+
+	d.SetId(path)
+
+	return raftSnapshotAgentConfigRead(d, meta)
+}
+
+func raftSnapshotAgentConfigRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	name, err := raftSnapshotAgentConfigNameFromPath(path)
+	if err != nil {
+		return fmt.Errorf("invalid path %q for Raft automated snapshot config: %s", path, err)
+	}
+
+	log.Printf("[DEBUG] Reading Raft automated snapshot config %q", name)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading Raft automated snapshot config %q: %s", name, err)
+	}
+	log.Printf("[DEBUG] Read Raft automated snapshot config %q", name)
+	if resp == nil {
+		log.Printf("[WARN] Raft automated snapshot config %q not found, removing from state", name)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("name", name)
+
+	if v, ok := resp.Data["interval"].(json.Number); ok {
+		n, err := v.Int64()
+		if err != nil {
+			return fmt.Errorf("expected interval %q to be a number, and it isn't", v)
+		}
+		d.Set("interval_seconds", n)
+	}
+
+	storageType, _ := resp.Data["storage_type"].(string)
+	fields := raftSnapshotAgentConfigStorageFields[storageType]
+
+	keys := []string{"retain", "path_prefix", "file_prefix", "storage_type"}
+	keys = append(keys, fields.required...)
+	keys = append(keys, fields.optional...)
+	for _, k := range keys {
+		if raftSnapshotAgentConfigIsSensitive(k) {
+			continue
+		}
+		v, ok := resp.Data[k]
+		if !ok {
+			continue
+		}
+		if n, ok := v.(json.Number); ok {
+			i, err := n.Int64()
+			if err != nil {
+				return fmt.Errorf("expected %s %q to be a number, and it isn't", k, n)
+			}
+			v = i
+		}
+		if err := d.Set(k, v); err != nil {
+			return fmt.Errorf("error setting %s for Raft automated snapshot config %q: %s", k, name, err)
+		}
+	}
+
+	return nil
+}
+
+func raftSnapshotAgentConfigDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting Raft automated snapshot config %q", path)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return fmt.Errorf("error deleting Raft automated snapshot config %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted Raft automated snapshot config %q", path)
+
+	return nil
+}
+
+func raftSnapshotAgentConfigIsSensitive(k string) bool {
+	for _, s := range raftSnapshotAgentConfigSensitiveFields {
+		if k == s {
+			return true
+		}
+	}
+	return false
+}
+
+func raftSnapshotAgentConfigNameFromPath(path string) (string, error) {
+	if !raftSnapshotAgentConfigNameFromPathRegex.MatchString(path) {
+		return "", fmt.Errorf("no name found")
+	}
+	res := raftSnapshotAgentConfigNameFromPathRegex.FindStringSubmatch(path)
+	if len(res) != 2 {
+		return "", fmt.Errorf("unexpected number of matches (%d) for name", len(res))
+	}
+	return strings.Trim(res[1], "/"), nil
+}
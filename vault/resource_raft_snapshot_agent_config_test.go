@@ -0,0 +1,146 @@
+package vault
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccRaftSnapshotAgentConfig_local(t *testing.T) {
+	if os.Getenv("TF_ACC_ENTERPRISE") == "" {
+		t.Skip("TF_ACC_ENTERPRISE is not set, test is applicable only for Enterprise version of Vault")
+	}
+
+	name := acctest.RandomWithPrefix("tf-test-snapshot")
+	resourceName := "vault_raft_snapshot_agent_config.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheckRaft(t) },
+		CheckDestroy: testAccRaftSnapshotAgentConfigCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRaftSnapshotAgentConfigLocal(name, 3600, 1),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", raftSnapshotAgentConfigPath(name)),
+					resource.TestCheckResourceAttr(resourceName, "name", name),
+					resource.TestCheckResourceAttr(resourceName, "interval_seconds", "3600"),
+					resource.TestCheckResourceAttr(resourceName, "retain", "1"),
+					resource.TestCheckResourceAttr(resourceName, "path_prefix", "/tmp"),
+					resource.TestCheckResourceAttr(resourceName, "file_prefix", "vault-snapshot"),
+					resource.TestCheckResourceAttr(resourceName, "storage_type", "local"),
+					resource.TestCheckResourceAttr(resourceName, "local_max_space", "10000000"),
+				),
+			},
+			{
+				Config: testAccRaftSnapshotAgentConfigLocal(name, 7200, 5),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "interval_seconds", "7200"),
+					resource.TestCheckResourceAttr(resourceName, "retain", "5"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestRaftSnapshotAgentConfigCustomizeDiff(t *testing.T) {
+	tests := []struct {
+		config   map[string]interface{}
+		expected string
+	}{
+		{
+			config: map[string]interface{}{
+				"storage_type":  "aws-s3",
+				"aws_s3_region": "us-east-1",
+			},
+			expected: `aws_s3_bucket is required when storage_type is "aws-s3"`,
+		},
+		{
+			config: map[string]interface{}{
+				"storage_type":      "local",
+				"google_gcs_bucket": "snapshots",
+			},
+			expected: `google_gcs_bucket can only be set when storage_type is "google-gcs"`,
+		},
+		{
+			config: map[string]interface{}{
+				"storage_type":  "aws-s3",
+				"aws_s3_bucket": "snapshots",
+				"aws_s3_region": "us-east-1",
+			},
+		},
+	}
+
+	for _, test := range tests {
+		test.config["name"] = "test"
+		test.config["interval_seconds"] = 3600
+		test.config["path_prefix"] = "snapshots"
+
+		_, err := raftSnapshotAgentConfigResource().Diff(nil, terraform.NewResourceConfigRaw(test.config), nil)
+		if test.expected == "" {
+			if err != nil {
+				t.Errorf("expected no error for %v, got %s", test.config, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), test.expected) {
+			t.Errorf("expected error %q for %v, got %v", test.expected, test.config, err)
+		}
+	}
+}
+
+func TestRaftSnapshotAgentConfigNameFromPath(t *testing.T) {
+	name, err := raftSnapshotAgentConfigNameFromPath("sys/storage/raft/snapshot-auto/config/hourly")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if name != "hourly" {
+		t.Errorf("expected name %q, got %q", "hourly", name)
+	}
+
+	if _, err := raftSnapshotAgentConfigNameFromPath("sys/storage/raft/configuration"); err == nil {
+		t.Error("expected an error parsing a path without a name")
+	}
+}
+
+func testAccRaftSnapshotAgentConfigCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_raft_snapshot_agent_config" {
+			continue
+		}
+		resp, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if resp != nil {
+			return fmt.Errorf("Raft automated snapshot config %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccRaftSnapshotAgentConfigLocal(name string, interval, retain int) string {
+	return fmt.Sprintf(`
+resource "vault_raft_snapshot_agent_config" "test" {
+  name             = "%s"
+  interval_seconds = %d
+  retain           = %d
+  path_prefix      = "/tmp"
+  storage_type     = "local"
+  local_max_space  = 10000000
+}
+`, name, interval, retain)
+}
@@ -0,0 +1,88 @@
+package vault
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io/ioutil"
+	"path/filepath"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+)
+
+func TestAccRaftSnapshot(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "vault.snap")
+	resourceName := "vault_raft_snapshot.test"
+	var checksum string
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheckRaft(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRaftSnapshotConfig(path, "2021-01"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "id", path),
+					testAccRaftSnapshotCheckFile(resourceName, &checksum, false),
+				),
+			},
+			{
+				// Unchanged triggers must not take the snapshot again.
+				Config: testAccRaftSnapshotConfig(path, "2021-01"),
+				Check:  testAccRaftSnapshotCheckFile(resourceName, &checksum, false),
+			},
+			{
+				Config: testAccRaftSnapshotConfig(path, "2021-02"),
+				Check:  testAccRaftSnapshotCheckFile(resourceName, &checksum, true),
+			},
+		},
+	})
+}
+
+// testAccRaftSnapshotCheckFile checks the file matches the recorded checksum
+// and size. Unless retaken, the snapshot must be the one previously checked; a
+// retaken snapshot of an idle cluster can be identical, so it isn't compared.
+func testAccRaftSnapshotCheckFile(name string, previous *string, retaken bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource %q not found in state", name)
+		}
+
+		data, err := ioutil.ReadFile(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		sum := sha256.Sum256(data)
+		checksum := hex.EncodeToString(sum[:])
+
+		if rs.Primary.Attributes["sha256"] != checksum {
+			return fmt.Errorf("expected sha256 %q, got %q", checksum, rs.Primary.Attributes["sha256"])
+		}
+		if rs.Primary.Attributes["size"] != strconv.Itoa(len(data)) {
+			return fmt.Errorf("expected size %d, got %q", len(data), rs.Primary.Attributes["size"])
+		}
+
+		if *previous != "" && !retaken && checksum != *previous {
+			return fmt.Errorf("expected the snapshot not to be taken again, sha256 changed from %q to %q", *previous, checksum)
+		}
+		*previous = checksum
+
+		return nil
+	}
+}
+
+func testAccRaftSnapshotConfig(path, period string) string {
+	return fmt.Sprintf(`
+resource "vault_raft_snapshot" "test" {
+  path = "%s"
+
+  triggers = {
+    period = "%s"
+  }
+}
+`, path, period)
+}
@@ -32,9 +32,10 @@ func rgpPolicyResource() *schema.Resource {
 			},
 
 			"policy": {
-				Type:        schema.TypeString,
-				Required:    true,
-				Description: "The policy document",
+				Type:             schema.TypeString,
+				Required:         true,
+				Description:      "The policy document",
+				DiffSuppressFunc: sentinelPolicyDiffSuppress,
 			},
 		},
 	}
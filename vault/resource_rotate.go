@@ -0,0 +1,76 @@
+package vault
+
+import (
+	"fmt"
+	"log"
+	"strconv"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func rotateResource() *schema.Resource {
+	return &schema.Resource{
+		Create: rotateCreate,
+		Read:   rotateRead,
+		Delete: rotateDelete,
+
+		Schema: map[string]*schema.Schema{
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary map of values that, when changed, will rotate the barrier key again.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"term": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Term of the barrier key installed by the rotation.",
+			},
+			"install_time": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Description: "Time the barrier key installed by the rotation was installed, in RFC3339 format.",
+			},
+		},
+	}
+}
+
+func rotateCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	log.Printf("[DEBUG] Rotating barrier key")
+	if err := client.Sys().Rotate(); err != nil {
+		return fmt.Errorf("error rotating barrier key: %s", err)
+	}
+	log.Printf("[DEBUG] Rotated barrier key")
+
+	log.Printf("[DEBUG] Reading barrier key status")
+	status, err := client.Sys().KeyStatus()
+	if err != nil {
+		return fmt.Errorf("error reading barrier key status: %s", err)
+	}
+	log.Printf("[DEBUG] Read barrier key status")
+
+	d.SetId(strconv.Itoa(status.Term))
+	d.Set("term", status.Term)
+	d.Set("install_time", status.InstallTime.Format(time.RFC3339))
+
+	return rotateRead(d, meta)
+}
+
+func rotateRead(d *schema.ResourceData, meta interface{}) error {
+	// The term is recorded when rotating, later rotations don't change it.
+	return nil
+}
+
+func rotateDelete(d *schema.ResourceData, meta interface{}) error {
+	// The keyring keeps every earlier term to decrypt existing data, and
+	// there is no way to make one of them active again.
+	log.Printf("[DEBUG] Removing barrier key rotation to term %q from state; the keyring is left as it is", d.Id())
+	return nil
+}
@@ -0,0 +1,81 @@
+package vault
+
+import (
+	"fmt"
+	"strconv"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccRotate(t *testing.T) {
+	resourceName := "vault_rotate.test"
+	var term int
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRotateConfig("2021-01"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttrSet(resourceName, "install_time"),
+					testAccRotateCheckTerm(resourceName, &term, 0),
+				),
+			},
+			{
+				// Unchanged triggers must not rotate the key again.
+				Config: testAccRotateConfig("2021-01"),
+				Check:  testAccRotateCheckTerm(resourceName, &term, 0),
+			},
+			{
+				Config: testAccRotateConfig("2021-02"),
+				Check:  testAccRotateCheckTerm(resourceName, &term, 1),
+			},
+		},
+	})
+}
+
+// testAccRotateCheckTerm checks the recorded term is the current one, and
+// that it moved on by increment since the previous check.
+func testAccRotateCheckTerm(name string, previous *int, increment int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("resource %q not found in state", name)
+		}
+
+		term, err := strconv.Atoi(rs.Primary.Attributes["term"])
+		if err != nil {
+			return fmt.Errorf("expected term to be a number, got %q", rs.Primary.Attributes["term"])
+		}
+
+		client := testProvider.Meta().(*api.Client)
+		status, err := client.Sys().KeyStatus()
+		if err != nil {
+			return err
+		}
+		if status.Term != term {
+			return fmt.Errorf("expected the current term %d to be the recorded term %d", status.Term, term)
+		}
+
+		if *previous != 0 && term != *previous+increment {
+			return fmt.Errorf("expected term %d, got %d", *previous+increment, term)
+		}
+		*previous = term
+
+		return nil
+	}
+}
+
+func testAccRotateConfig(period string) string {
+	return fmt.Sprintf(`
+resource "vault_rotate" "test" {
+  triggers = {
+    period = "%s"
+  }
+}
+`, period)
+}
@@ -1,12 +1,14 @@
 package vault
 
 import (
+	"bytes"
 	"fmt"
 	"log"
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/vault/api"
+	"golang.org/x/crypto/ssh"
 )
 
 func sshSecretBackendCAResource() *schema.Resource {
@@ -31,18 +33,21 @@ func sshSecretBackendCAResource() *schema.Resource {
 				},
 			},
 			"generate_signing_key": {
-				Type:        schema.TypeBool,
-				Optional:    true,
-				ForceNew:    true,
-				Description: "Whether Vault should generate the signing key pair internally.",
+				Type:             schema.TypeBool,
+				Optional:         true,
+				Computed:         true,
+				ForceNew:         true,
+				Description:      "Whether Vault should generate the signing key pair internally. Defaults to true unless private_key is set.",
+				DiffSuppressFunc: sshSecretBackendCAGenerateSigningKeyDiffSuppress,
 			},
 			"private_key": {
-				Type:        schema.TypeString,
-				Optional:    true,
-				ForceNew:    true,
-				Sensitive:   true,
-				Computed:    true,
-				Description: "Private key part the SSH CA key pair; required if generate_signing_key is false.",
+				Type:             schema.TypeString,
+				Optional:         true,
+				ForceNew:         true,
+				Sensitive:        true,
+				Computed:         true,
+				Description:      "Private key part the SSH CA key pair; required if generate_signing_key is false.",
+				DiffSuppressFunc: sshSecretBackendCAPrivateKeyDiffSuppress,
 			},
 			"public_key": {
 				Type:        schema.TypeString,
@@ -59,15 +64,18 @@ func sshSecretBackendCACreate(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 	backend := d.Get("backend").(string)
 
+	// Vault generates the key pair unless one is provided, or it's told not
+	// to explicitly.
 	data := make(map[string]interface{})
-	if generateSigningKey, ok := d.Get("generate_signing_key").(bool); ok {
-		data["generate_signing_key"] = generateSigningKey
+	privateKey := d.Get("private_key").(string)
+	generateSigningKey := privateKey == ""
+	if v, ok := d.GetOkExists("generate_signing_key"); ok {
+		generateSigningKey = v.(bool)
 	}
-	if privateKey, ok := d.Get("private_key").(string); ok {
+	data["generate_signing_key"] = generateSigningKey
+	if privateKey != "" {
 		data["private_key"] = privateKey
-	}
-	if publicKey, ok := d.Get("public_key").(string); ok {
-		data["public_key"] = publicKey
+		data["public_key"] = d.Get("public_key").(string)
 	}
 
 	log.Printf("[DEBUG] Writing CA information on SSH backend %q", backend)
@@ -78,6 +86,7 @@ func sshSecretBackendCACreate(d *schema.ResourceData, meta interface{}) error {
 	log.Printf("[DEBUG] Written CA information on SSH backend %q", backend)
 
 	d.SetId(backend)
+	d.Set("generate_signing_key", generateSigningKey)
 	return sshSecretBackendCARead(d, meta)
 }
 
@@ -128,3 +137,34 @@ func sshSecretBackendCADelete(d *schema.ResourceData, meta interface{}) error {
 
 	return nil
 }
+
+// sshSecretBackendCAPrivateKeyDiffSuppress ignores a private_key that isn't in
+// state yet, which is the case after import as Vault never returns it, as long
+// as it matches the public_key Vault has.
+func sshSecretBackendCAPrivateKeyDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	if d.Id() == "" || old != "" || new == "" {
+		return false
+	}
+
+	signer, err := ssh.ParsePrivateKey([]byte(new))
+	if err != nil {
+		return false
+	}
+	publicKey, _, _, _, err := ssh.ParseAuthorizedKey([]byte(d.Get("public_key").(string)))
+	if err != nil {
+		return false
+	}
+
+	return bytes.Equal(signer.PublicKey().Marshal(), publicKey.Marshal())
+}
+
+// sshSecretBackendCAGenerateSigningKeyDiffSuppress ignores
+// generate_signing_key being set after import, where it isn't in state as
+// Vault never returns it, and being turned on for a CA that wasn't created
+// with a private_key.
+func sshSecretBackendCAGenerateSigningKeyDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	if d.Id() == "" {
+		return false
+	}
+	return old == "" || (old == "false" && new == "true" && d.Get("private_key").(string) == "")
+}
@@ -82,10 +82,22 @@ func sshSecretBackendRoleResource() *schema.Resource {
 			"default_extensions": {
 				Type:     schema.TypeMap,
 				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"default_extensions_template": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				Default:     false,
+				Description: "If set, default_extensions can contain identity template values. Requires Vault 1.11+.",
 			},
 			"default_critical_options": {
 				Type:     schema.TypeMap,
 				Optional: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
 			},
 			"allowed_users_template": {
 				Type:     schema.TypeBool,
@@ -148,9 +160,9 @@ func sshSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) error {
 		"allow_user_key_ids":      d.Get("allow_user_key_ids").(bool),
 	}
 
-	if v, ok := d.GetOk("allowed_critical_options"); ok {
-		data["allowed_critical_options"] = v.(string)
-	}
+	// always send the critical options, extensions and their defaults so
+	// that removing them from the configuration clears them in Vault
+	data["allowed_critical_options"] = d.Get("allowed_critical_options").(string)
 
 	if v, ok := d.GetOk("allowed_domains"); ok {
 		data["allowed_domains"] = v.(string)
@@ -160,17 +172,11 @@ func sshSecretBackendRoleWrite(d *schema.ResourceData, meta interface{}) error {
 		data["cidr_list"] = v.(string)
 	}
 
-	if v, ok := d.GetOk("allowed_extensions"); ok {
-		data["allowed_extensions"] = v.(string)
-	}
+	data["allowed_extensions"] = d.Get("allowed_extensions").(string)
+	data["default_extensions"] = d.Get("default_extensions")
+	data["default_critical_options"] = d.Get("default_critical_options")
 
-	if v, ok := d.GetOk("default_extensions"); ok {
-		data["default_extensions"] = v
-	}
-
-	if v, ok := d.GetOk("default_critical_options"); ok {
-		data["default_critical_options"] = v
-	}
+	data["default_extensions_template"] = d.Get("default_extensions_template").(bool)
 
 	if v, ok := d.GetOk("allowed_users_template"); ok {
 		data["allowed_users_template"] = v.(bool)
@@ -260,6 +266,9 @@ func sshSecretBackendRoleRead(d *schema.ResourceData, meta interface{}) error {
 	d.Set("allowed_extensions", role.Data["allowed_extensions"])
 	d.Set("default_extensions", role.Data["default_extensions"])
 	d.Set("default_critical_options", role.Data["default_critical_options"])
+	if v, ok := role.Data["default_extensions_template"]; ok {
+		d.Set("default_extensions_template", v)
+	}
 	d.Set("allowed_users_template", role.Data["allowed_users_template"])
 	d.Set("allowed_users", role.Data["allowed_users"])
 	d.Set("default_user", role.Data["default_user"])
@@ -1,13 +1,17 @@
 package vault
 
 import (
+	"crypto/rand"
+	"crypto/rsa"
 	"fmt"
+	"reflect"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 	"github.com/hashicorp/vault/api"
+	"golang.org/x/crypto/ssh"
 )
 
 func TestAccSSHSecretBackendRole_basic(t *testing.T) {
@@ -139,6 +143,96 @@ func TestAccSSHSecretBackendRole_import(t *testing.T) {
 	})
 }
 
+func TestAccSSHSecretBackendRole_defaultExtensions(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test/ssh")
+	name := acctest.RandomWithPrefix("tf-test-role")
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccSSHSecretBackendRoleCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSSHSecretBackendRoleConfig_defaultExtensions(name, backend, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_ssh_secret_backend_role.test_role", "allowed_critical_options", "force-command"),
+					resource.TestCheckResourceAttr("vault_ssh_secret_backend_role.test_role", "default_extensions.%", "2"),
+					resource.TestCheckResourceAttr("vault_ssh_secret_backend_role.test_role", "default_extensions.permit-pty", ""),
+					resource.TestCheckResourceAttr("vault_ssh_secret_backend_role.test_role", "default_extensions.permit-port-forwarding", ""),
+					resource.TestCheckResourceAttr("vault_ssh_secret_backend_role.test_role", "default_critical_options.%", "1"),
+					resource.TestCheckResourceAttr("vault_ssh_secret_backend_role.test_role", "default_critical_options.force-command", "/bin/true"),
+					resource.TestCheckResourceAttr("vault_ssh_secret_backend_role.test_role", "default_extensions_template", "false"),
+					testAccSSHSecretBackendRoleCheckSignedCert(backend, name),
+				),
+			},
+			{
+				Config: testAccSSHSecretBackendRoleConfig_defaultExtensions(name, backend, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_ssh_secret_backend_role.test_role", "default_extensions_template", "true"),
+				),
+			},
+			{
+				// Removing the attribute must turn templating back off.
+				Config: testAccSSHSecretBackendRoleConfig_defaultExtensions(name, backend, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_ssh_secret_backend_role.test_role", "default_extensions_template", "false"),
+				),
+			},
+			{
+				Config:   testAccSSHSecretBackendRoleConfig_defaultExtensions(name, backend, false),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+// testAccSSHSecretBackendRoleCheckSignedCert signs a fresh public key with
+// the role and checks the certificate carries the default extensions and
+// critical options.
+func testAccSSHSecretBackendRoleCheckSignedCert(backend, name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		key, err := rsa.GenerateKey(rand.Reader, 2048)
+		if err != nil {
+			return err
+		}
+		pub, err := ssh.NewPublicKey(&key.PublicKey)
+		if err != nil {
+			return err
+		}
+
+		client := testProvider.Meta().(*api.Client)
+		resp, err := client.Logical().Write(backend+"/sign/"+name, map[string]interface{}{
+			"public_key": string(ssh.MarshalAuthorizedKey(pub)),
+		})
+		if err != nil {
+			return fmt.Errorf("error signing key: %s", err)
+		}
+
+		signed, _, _, _, err := ssh.ParseAuthorizedKey([]byte(resp.Data["signed_key"].(string)))
+		if err != nil {
+			return err
+		}
+		cert, ok := signed.(*ssh.Certificate)
+		if !ok {
+			return fmt.Errorf("expected a certificate, got %T", signed)
+		}
+
+		expectedExtensions := map[string]string{
+			"permit-pty":             "",
+			"permit-port-forwarding": "",
+		}
+		if !reflect.DeepEqual(cert.Extensions, expectedExtensions) {
+			return fmt.Errorf("expected extensions %v, got %v", expectedExtensions, cert.Extensions)
+		}
+		expectedCriticalOptions := map[string]string{
+			"force-command": "/bin/true",
+		}
+		if !reflect.DeepEqual(cert.CriticalOptions, expectedCriticalOptions) {
+			return fmt.Errorf("expected critical options %v, got %v", expectedCriticalOptions, cert.CriticalOptions)
+		}
+		return nil
+	}
+}
+
 func testAccSSHSecretBackendRoleCheckDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -207,6 +301,44 @@ resource "vault_ssh_secret_backend_role" "test_role" {
 `, path, name)
 }
 
+func testAccSSHSecretBackendRoleConfig_defaultExtensions(name, path string, template bool) string {
+	var templateConfig string
+	if template {
+		templateConfig = "\n  default_extensions_template = true\n"
+	}
+	return fmt.Sprintf(`
+resource "vault_mount" "example" {
+  path = "%s"
+  type = "ssh"
+}
+
+resource "vault_ssh_secret_backend_ca" "test" {
+  backend              = vault_mount.example.path
+  generate_signing_key = true
+}
+
+resource "vault_ssh_secret_backend_role" "test_role" {
+  name                     = "%s"
+  backend                  = vault_ssh_secret_backend_ca.test.backend
+  key_type                 = "ca"
+  allow_user_certificates  = true
+  allowed_users            = "*"
+  default_user             = "usr"
+  allowed_critical_options = "force-command"
+  allowed_extensions       = "permit-pty,permit-port-forwarding"
+
+  default_extensions = {
+    "permit-pty"             = ""
+    "permit-port-forwarding" = ""
+  }
+
+  default_critical_options = {
+    "force-command" = "/bin/true"
+  }
+%s}
+`, path, name, templateConfig)
+}
+
 func testAccSSHSecretBackendRoleOTPConfig_basic(name, path string) string {
 	return fmt.Sprintf(`
 resource "vault_mount" "example" {
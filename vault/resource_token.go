@@ -1,6 +1,9 @@
 package vault
 
 import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
 	"fmt"
 	"log"
 	"strings"
@@ -8,9 +11,16 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/helper/encryption"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/terraform-provider-vault/util"
 	"github.com/hashicorp/vault/api"
 )
 
+const (
+	tokenTypeService = "service"
+	tokenTypeBatch   = "batch"
+)
+
 func tokenResource() *schema.Resource {
 	return &schema.Resource{
 		Create: tokenCreate,
@@ -19,9 +29,14 @@ func tokenResource() *schema.Resource {
 		Delete: tokenDelete,
 		Exists: tokenExists,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: tokenImport,
 		},
+		CustomizeDiff: tokenCustomizeDiff,
 
+		// Vault doesn't allow modifying a token after creation, so every
+		// argument affecting the token itself forces a new one. Reissuing a
+		// token "in place" would still hand out a different client token and
+		// accessor, which is exactly what a replacement does.
 		Schema: map[string]*schema.Schema{
 			"role_name": {
 				Type:        schema.TypeString,
@@ -36,9 +51,10 @@ func tokenResource() *schema.Resource {
 				Optional: true,
 				ForceNew: true,
 				Elem: &schema.Schema{
-					Type: schema.TypeString,
+					Type:         schema.TypeString,
+					ValidateFunc: validateTokenPolicy,
 				},
-				Description: "List of policies.",
+				Description: "List of policies. The policies of a token can't be changed once it is created, so changing them replaces the token.",
 			},
 			"no_parent": {
 				Type:        schema.TypeBool,
@@ -62,6 +78,14 @@ func tokenResource() *schema.Resource {
 				Computed:    true,
 				Description: "Flag to allow the token to be renewed",
 			},
+			"type": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				ForceNew:     true,
+				Computed:     true,
+				Description:  "The type of token to create, either service or batch.",
+				ValidateFunc: validation.StringInSlice([]string{tokenTypeService, tokenTypeBatch}, false),
+			},
 			"ttl": {
 				Type:        schema.TypeString,
 				Required:    false,
@@ -69,6 +93,16 @@ func tokenResource() *schema.Resource {
 				ForceNew:    true,
 				Description: "The TTL period of the token.",
 			},
+			"bound_cidrs": {
+				Type:     schema.TypeSet,
+				Optional: true,
+				ForceNew: true,
+				Computed: true,
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+				Description: "List of CIDR blocks the token can be used from.",
+			},
 			"explicit_max_ttl": {
 				Type:        schema.TypeString,
 				Required:    false,
@@ -97,6 +131,11 @@ func tokenResource() *schema.Resource {
 				Computed:    true,
 				Description: "The number of allowed uses of the token.",
 			},
+			"num_uses_remaining": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "The number of remaining uses of the token.",
+			},
 			"period": {
 				Type:        schema.TypeString,
 				Required:    false,
@@ -145,10 +184,18 @@ func tokenResource() *schema.Resource {
 				Sensitive:   true,
 			},
 			"pgp_key": {
-				Type:        schema.TypeString,
-				ForceNew:    true,
-				Optional:    true,
-				Description: "The PGP key (base64 encoded) to encrypt the token.",
+				Type:          schema.TypeString,
+				ForceNew:      true,
+				Optional:      true,
+				Description:   "The PGP key (base64 encoded) to encrypt the token.",
+				ConflictsWith: []string{"revoke_orphan"},
+			},
+			"revoke_orphan": {
+				Type:          schema.TypeBool,
+				Optional:      true,
+				Default:       false,
+				Description:   "Revoke only the token on destroy, orphaning its child tokens instead of revoking them too. Requires the client_token, so it can't be used with pgp_key or imported tokens.",
+				ConflictsWith: []string{"pgp_key"},
 			},
 			"encrypted_client_token": {
 				Type:        schema.TypeString,
@@ -212,6 +259,22 @@ func tokenCreate(d *schema.ResourceData, meta interface{}) error {
 		createRequest.Renewable = &renewable
 	}
 
+	tokenType := d.Get("type").(string)
+	if tokenType != "" {
+		createRequest.Type = tokenType
+	}
+
+	if tokenType == tokenTypeBatch {
+		// batch tokens have no accessor, so they can only be tracked
+		// through the client token itself.
+		if _, ok := d.GetOk("wrapping_ttl"); ok {
+			return fmt.Errorf("wrapping_ttl is not supported for batch tokens")
+		}
+		if _, ok := d.GetOk("pgp_key"); ok {
+			return fmt.Errorf("pgp_key is not supported for batch tokens")
+		}
+	}
+
 	if v, ok := d.GetOk("wrapping_ttl"); ok {
 		wrappingTTL := v.(string)
 		token := client.Token()
@@ -232,9 +295,11 @@ func tokenCreate(d *schema.ResourceData, meta interface{}) error {
 	var resp *api.Secret
 	var accessor string
 
+	boundCIDRs := util.TerraformSetToStringArray(d.Get("bound_cidrs"))
+
 	if role != "" {
 		log.Printf("[DEBUG] Creating token with role %q", role)
-		resp, err = client.Auth().Token().CreateWithRole(createRequest, role)
+		resp, err = tokenCreateRequest(client, "auth/token/create/"+role, createRequest, boundCIDRs)
 		if err != nil {
 			return fmt.Errorf("error creating token with role %q: %s", role, err)
 		}
@@ -246,9 +311,30 @@ func tokenCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 
 		log.Printf("[DEBUG] Created token accessor %q with role %q", accessor, role)
+	} else if createRequest.NoParent {
+		// Setting no_parent on a regular create requires sudo, whereas the
+		// create-orphan endpoint can be granted through a regular policy.
+		createRequest.NoParent = false
+
+		log.Printf("[DEBUG] Creating orphan token")
+		resp, err = tokenCreateRequest(client, "auth/token/create-orphan", createRequest, boundCIDRs)
+		if err != nil {
+			if util.IsPermissionDenied(err) {
+				return fmt.Errorf("error creating orphan token, the provider token requires the update capability on auth/token/create-orphan: %s", err)
+			}
+			return fmt.Errorf("error creating orphan token: %s", err)
+		}
+
+		if wrapped {
+			accessor = resp.WrapInfo.WrappedAccessor
+		} else {
+			accessor = resp.Auth.Accessor
+		}
+
+		log.Printf("[DEBUG] Created orphan token accessor %q", accessor)
 	} else {
 		log.Printf("[DEBUG] Creating token")
-		resp, err = client.Auth().Token().Create(createRequest)
+		resp, err = tokenCreateRequest(client, "auth/token/create", createRequest, boundCIDRs)
 		if err != nil {
 			return fmt.Errorf("error creating token: %s", err)
 		}
@@ -285,7 +371,11 @@ func tokenCreate(d *schema.ResourceData, meta interface{}) error {
 		}
 	}
 
-	d.SetId(accessor)
+	if tokenType == tokenTypeBatch {
+		d.SetId(tokenBatchID(resp.Auth.ClientToken))
+	} else {
+		d.SetId(accessor)
+	}
 
 	return tokenRead(d, meta)
 }
@@ -297,7 +387,7 @@ func tokenRead(d *schema.ResourceData, meta interface{}) error {
 	accessor := d.Id()
 
 	log.Printf("[DEBUG] Reading token accessor %q", accessor)
-	resp, err := client.Auth().Token().LookupAccessor(accessor)
+	resp, err := tokenLookup(client, d)
 	if err != nil {
 		log.Printf("[WARN] Token not found, removing from state")
 		d.SetId("")
@@ -318,9 +408,23 @@ func tokenRead(d *schema.ResourceData, meta interface{}) error {
 
 	d.Set("policies", policies)
 	d.Set("no_parent", resp.Data["orphan"])
+	// batch tokens are never renewable, regardless of what was requested,
+	// so always reflect the value reported by Vault.
 	d.Set("renewable", resp.Data["renewable"])
+	if v, ok := resp.Data["type"]; ok {
+		d.Set("type", v)
+	}
+	if v, ok := resp.Data["bound_cidrs"]; ok {
+		d.Set("bound_cidrs", v)
+	}
 	d.Set("display_name", strings.TrimPrefix(resp.Data["display_name"].(string), "token-"))
-	d.Set("num_uses", resp.Data["num_uses"])
+	// num_uses decrements every time the token is used, so keep the
+	// requested value and only report what's left through num_uses_remaining.
+	// It is read back only when unknown, i.e. on import.
+	if _, ok := d.GetOk("num_uses"); !ok {
+		d.Set("num_uses", resp.Data["num_uses"])
+	}
+	d.Set("num_uses_remaining", resp.Data["num_uses"])
 	if _, ok := d.GetOk("pgp_key"); !ok {
 		d.Set("pgp_key", "")
 	}
@@ -392,6 +496,26 @@ func tokenDelete(d *schema.ResourceData, meta interface{}) error {
 
 	token := d.Id()
 
+	if d.Get("type").(string) == tokenTypeBatch {
+		log.Printf("[DEBUG] Batch token %q cannot be revoked, it will expire with its TTL", token)
+		return nil
+	}
+
+	if d.Get("revoke_orphan").(bool) {
+		clientToken := d.Get("client_token").(string)
+		if clientToken == "" {
+			return fmt.Errorf("revoke_orphan requires the client_token of token %q, which isn't known for encrypted or imported tokens", token)
+		}
+
+		log.Printf("[DEBUG] Deleting token %q, orphaning its children", token)
+		if err := client.Auth().Token().RevokeOrphan(clientToken); err != nil {
+			return fmt.Errorf("error deleting token %q: %s", token, err)
+		}
+		log.Printf("[DEBUG] Deleted token accessor %q", token)
+
+		return nil
+	}
+
 	log.Printf("[DEBUG] Deleting token %q", token)
 	err := client.Auth().Token().RevokeAccessor(token)
 	if err != nil {
@@ -402,12 +526,42 @@ func tokenDelete(d *schema.ResourceData, meta interface{}) error {
 	return nil
 }
 
+// tokenCustomizeDiff rejects revoke_orphan for an existing token whose
+// client_token isn't known, e.g. an imported token, which would otherwise only
+// fail once the token is destroyed.
+func tokenCustomizeDiff(d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.NewValueKnown("revoke_orphan") {
+		return nil
+	}
+	if d.Get("revoke_orphan").(bool) && d.Get("client_token").(string) == "" {
+		return fmt.Errorf("revoke_orphan requires the client_token of token %q, which isn't known for encrypted or imported tokens", d.Id())
+	}
+	return nil
+}
+
+// tokenImport adopts an existing service token by its accessor. The client
+// token itself can't be recovered from the accessor, so client_token is left
+// empty and the imported token won't be renewed or revoked as an orphan by
+// the provider.
+func tokenImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	client := meta.(*api.Client)
+	accessor := d.Id()
+
+	log.Printf("[DEBUG] Importing token accessor %q", accessor)
+	if _, err := client.Auth().Token().LookupAccessor(accessor); err != nil {
+		return nil, fmt.Errorf("error looking up token accessor %q: %s", accessor, err)
+	}
+	d.Set("revoke_orphan", false)
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func tokenExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 	client := meta.(*api.Client)
 	accessor := d.Id()
 
 	log.Printf("[DEBUG] Checking if token accessor %q exists", accessor)
-	resp, err := client.Auth().Token().LookupAccessor(accessor)
+	resp, err := tokenLookup(client, d)
 	if err != nil {
 		log.Printf("[DEBUG] token accessor %q not found: %s", d.Id(), err)
 		return false, nil
@@ -415,6 +569,61 @@ func tokenExists(d *schema.ResourceData, meta interface{}) (bool, error) {
 	return resp != nil, nil
 }
 
+// validateTokenPolicy rejects the default policy, which Vault attaches
+// implicitly unless no_default_policy is set and which is never read back,
+// and warns about the root policy.
+func validateTokenPolicy(i interface{}, k string) (ws []string, es []error) {
+	v, ok := i.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	switch v {
+	case "default":
+		es = append(es, fmt.Errorf("%s must not contain the %q policy, it is managed implicitly, see no_default_policy", k, v))
+	case "root":
+		ws = append(ws, fmt.Sprintf("%s contains the %q policy, creating root tokens is discouraged and requires a root parent token", k, v))
+	}
+	return
+}
+
+// tokenCreateRequest creates a token through the given token create
+// endpoint. api.TokenCreateRequest has no field for bound CIDRs, so the
+// request is sent as raw data with bound_cidrs added.
+func tokenCreateRequest(client *api.Client, path string, createRequest *api.TokenCreateRequest, boundCIDRs []string) (*api.Secret, error) {
+	b, err := json.Marshal(createRequest)
+	if err != nil {
+		return nil, err
+	}
+
+	data := map[string]interface{}{}
+	if err := json.Unmarshal(b, &data); err != nil {
+		return nil, err
+	}
+	if len(boundCIDRs) > 0 {
+		data["bound_cidrs"] = boundCIDRs
+	}
+
+	return client.Logical().Write(path, data)
+}
+
+// tokenLookup looks up the token by its accessor, or by the client token
+// itself for batch tokens since those have no accessor.
+func tokenLookup(client *api.Client, d *schema.ResourceData) (*api.Secret, error) {
+	if d.Get("type").(string) == tokenTypeBatch {
+		return client.Auth().Token().Lookup(d.Get("client_token").(string))
+	}
+	return client.Auth().Token().LookupAccessor(d.Id())
+}
+
+// tokenBatchID derives a stable resource ID for a batch token, which
+// avoids exposing the token itself as the ID.
+func tokenBatchID(token string) string {
+	sum := sha256.Sum256([]byte(token))
+	return tokenTypeBatch + "-" + hex.EncodeToString(sum[:])
+}
+
 func tokenCheckLease(d *schema.ResourceData) bool {
 	accessor := d.Id()
 
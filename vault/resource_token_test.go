@@ -2,9 +2,14 @@ package vault
 
 import (
 	"fmt"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 	"github.com/hashicorp/vault/api"
+	"os"
+	"reflect"
+	"regexp"
 	"strconv"
 	"testing"
 	"time"
@@ -25,6 +30,28 @@ func testResourceTokenCheckDestroy(s *terraform.State) error {
 	return nil
 }
 
+func TestValidateTokenPolicy(t *testing.T) {
+	testCases := map[string]struct {
+		val   string
+		warns int
+		errs  int
+	}{
+		"regular": {val: "test"},
+		"default": {val: "default", errs: 1},
+		"root":    {val: "root", warns: 1},
+	}
+
+	for name, tc := range testCases {
+		ws, es := validateTokenPolicy(tc.val, "policies")
+		if len(ws) != tc.warns {
+			t.Fatalf("expected test case %q to produce %d warnings, got %v", name, tc.warns, ws)
+		}
+		if len(es) != tc.errs {
+			t.Fatalf("expected test case %q to produce %d errors, got %v", name, tc.errs, es)
+		}
+	}
+}
+
 func TestResourceToken_basic(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		Providers:    testProviders,
@@ -74,6 +101,25 @@ func TestResourceToken_import(t *testing.T) {
 	})
 }
 
+func TestResourceToken_importInvalidAccessor(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testResourceTokenCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceTokenConfig_basic(),
+			},
+			{
+				ResourceName:  "vault_token.test",
+				ImportState:   true,
+				ImportStateId: "invalid-accessor",
+				ExpectError:   regexp.MustCompile(`error looking up token accessor "invalid-accessor"`),
+			},
+		},
+	})
+}
+
 func testResourceTokenConfig_basic() string {
 	return `
 resource "vault_policy" "test" {
@@ -139,6 +185,322 @@ resource "vault_token" "test" {
 }`
 }
 
+func TestResourceToken_batch(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testResourceTokenCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceTokenConfig_batch(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_token.test", "type", "batch"),
+					resource.TestCheckResourceAttr("vault_token.test", "renewable", "false"),
+					resource.TestCheckResourceAttr("vault_token.test", "policies.#", "1"),
+					resource.TestCheckResourceAttrSet("vault_token.test", "lease_duration"),
+					resource.TestCheckResourceAttrSet("vault_token.test", "client_token"),
+				),
+			},
+			{
+				// the read-back renewable=false must not produce a diff
+				Config:   testResourceTokenConfig_batch(),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testResourceTokenConfig_batch() string {
+	return `
+resource "vault_policy" "test" {
+	name = "test"
+	policy = <<EOT
+path "secret/*" { capabilities = [ "list" ] }
+EOT
+}
+
+resource "vault_token" "test" {
+	policies = [ "${vault_policy.test.name}" ]
+	type = "batch"
+	ttl = "60s"
+}`
+}
+
+func TestResourceToken_orphanWithoutSudo(t *testing.T) {
+	if os.Getenv(resource.TestEnvVar) == "" {
+		t.Skip(fmt.Sprintf("Acceptance tests skipped unless env '%s' set", resource.TestEnvVar))
+	}
+	testAccPreCheck(t)
+
+	client := testAccClient(t)
+
+	policy := acctest.RandomWithPrefix("create-orphan")
+	// tokenCreate reads the token back by its accessor after creating it.
+	rules := `
+path "auth/token/create-orphan" { capabilities = ["update"] }
+path "auth/token/lookup-accessor" { capabilities = ["update"] }
+`
+	if err := client.Sys().PutPolicy(policy, rules); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := client.Sys().DeletePolicy(policy); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	parent, err := client.Auth().Token().Create(&api.TokenCreateRequest{
+		Policies: []string{policy},
+		TTL:      "5m",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	limited, err := client.Clone()
+	if err != nil {
+		t.Fatal(err)
+	}
+	limited.SetToken(parent.Auth.ClientToken)
+
+	d := schema.TestResourceDataRaw(t, tokenResource().Schema, map[string]interface{}{
+		"no_parent": true,
+		"ttl":       "60s",
+	})
+	if err := tokenCreate(d, limited); err != nil {
+		t.Fatal(err)
+	}
+	if d.Id() == "" {
+		t.Fatal("expected the token to be read back after creation")
+	}
+	defer func() {
+		if err := client.Auth().Token().RevokeAccessor(d.Id()); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	// the child token must be an orphan, outliving its short-lived parent
+	if err := client.Auth().Token().RevokeAccessor(parent.Auth.Accessor); err != nil {
+		t.Fatal(err)
+	}
+	resp, err := client.Auth().Token().LookupAccessor(d.Id())
+	if err != nil {
+		t.Fatalf("expected orphan token to outlive its parent: %s", err)
+	}
+	if orphan, _ := resp.Data["orphan"].(bool); !orphan {
+		t.Fatal("expected token to be an orphan")
+	}
+}
+
+func TestResourceToken_revokeOrphan(t *testing.T) {
+	if os.Getenv(resource.TestEnvVar) == "" {
+		t.Skip(fmt.Sprintf("Acceptance tests skipped unless env '%s' set", resource.TestEnvVar))
+	}
+	testAccPreCheck(t)
+
+	client := testAccClient(t)
+
+	policy := acctest.RandomWithPrefix("create-child")
+	if err := client.Sys().PutPolicy(policy, `path "auth/token/create" { capabilities = ["update"] }`); err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := client.Sys().DeletePolicy(policy); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	d := schema.TestResourceDataRaw(t, tokenResource().Schema, map[string]interface{}{
+		"policies":      []interface{}{policy},
+		"ttl":           "5m",
+		"revoke_orphan": true,
+	})
+	if err := tokenCreate(d, client); err != nil {
+		t.Fatal(err)
+	}
+
+	parent, err := client.Clone()
+	if err != nil {
+		t.Fatal(err)
+	}
+	parent.SetToken(d.Get("client_token").(string))
+	child, err := parent.Auth().Token().Create(&api.TokenCreateRequest{
+		Policies: []string{policy},
+		TTL:      "5m",
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	defer func() {
+		if err := client.Auth().Token().RevokeAccessor(child.Auth.Accessor); err != nil {
+			t.Error(err)
+		}
+	}()
+
+	if err := tokenDelete(d, client); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := client.Auth().Token().LookupAccessor(d.Id()); err == nil {
+		t.Fatal("expected the token to be revoked")
+	}
+	resp, err := client.Auth().Token().LookupAccessor(child.Auth.Accessor)
+	if err != nil {
+		t.Fatalf("expected the child token to outlive its parent: %s", err)
+	}
+	if orphan, _ := resp.Data["orphan"].(bool); !orphan {
+		t.Fatal("expected the child token to be orphaned")
+	}
+}
+
+func TestResourceToken_revokeOrphanWithoutClientToken(t *testing.T) {
+	d := schema.TestResourceDataRaw(t, tokenResource().Schema, map[string]interface{}{
+		"revoke_orphan": true,
+	})
+	d.SetId("accessor")
+	err := tokenDelete(d, (*api.Client)(nil))
+	if err == nil || !regexp.MustCompile(`revoke_orphan requires the client_token`).MatchString(err.Error()) {
+		t.Fatalf("expected an error about the missing client_token, got %v", err)
+	}
+}
+
+func TestResourceToken_revokeOrphanCustomizeDiff(t *testing.T) {
+	config := terraform.NewResourceConfigRaw(map[string]interface{}{
+		"revoke_orphan": true,
+	})
+
+	// A new token will have its client_token once created.
+	if _, err := tokenResource().Diff(nil, config, nil); err != nil {
+		t.Fatalf("expected no error for a new token, got %v", err)
+	}
+
+	for name, clientToken := range map[string]string{
+		"imported": "",
+		"created":  "s.token",
+	} {
+		state := &terraform.InstanceState{
+			ID: "accessor",
+			Attributes: map[string]string{
+				"id":            "accessor",
+				"client_token":  clientToken,
+				"revoke_orphan": "false",
+			},
+		}
+		_, err := tokenResource().Diff(state, config, nil)
+		if clientToken == "" {
+			if err == nil || !regexp.MustCompile(`revoke_orphan requires the client_token`).MatchString(err.Error()) {
+				t.Fatalf("%s: expected an error about the missing client_token, got %v", name, err)
+			}
+		} else if err != nil {
+			t.Fatalf("%s: expected no error, got %v", name, err)
+		}
+	}
+}
+
+func TestResourceToken_numUses(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testResourceTokenCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceTokenConfig_numUses(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_token.test", "num_uses", "5"),
+					resource.TestCheckResourceAttr("vault_token.test", "num_uses_remaining", "5"),
+					testResourceTokenUse("vault_token.test"),
+				),
+			},
+			{
+				// using the token must not cause it to be recreated
+				Config: testResourceTokenConfig_numUses(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_token.test", "num_uses", "5"),
+					resource.TestCheckResourceAttr("vault_token.test", "num_uses_remaining", "4"),
+				),
+			},
+		},
+	})
+}
+
+func testResourceTokenConfig_numUses() string {
+	return `
+resource "vault_policy" "test" {
+	name = "test"
+	policy = <<EOT
+path "secret/*" { capabilities = [ "list" ] }
+EOT
+}
+
+resource "vault_token" "test" {
+	policies = [ "${vault_policy.test.name}" ]
+	ttl = "60s"
+	num_uses = 5
+}`
+}
+
+func TestResourceToken_boundCIDRs(t *testing.T) {
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testResourceTokenCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testResourceTokenConfig_boundCIDRs(),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_token.test", "bound_cidrs.#", "1"),
+					testResourceTokenCheckBoundCIDRs("vault_token.test", "10.0.0.0/8"),
+				),
+			},
+			{
+				Config:   testResourceTokenConfig_boundCIDRs(),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func testResourceTokenConfig_boundCIDRs() string {
+	return `
+resource "vault_policy" "test" {
+	name = "test"
+	policy = <<EOT
+path "secret/*" { capabilities = [ "list" ] }
+EOT
+}
+
+resource "vault_token" "test" {
+	policies = [ "${vault_policy.test.name}" ]
+	ttl = "60s"
+	bound_cidrs = [ "10.0.0.0/8" ]
+}`
+}
+
+func testResourceTokenCheckBoundCIDRs(n string, cidrs ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		client := testProvider.Meta().(*api.Client)
+		resp, err := client.Auth().Token().LookupAccessor(rs.Primary.ID)
+		if err != nil {
+			return fmt.Errorf("Token could not be found: %s", err)
+		}
+
+		var found []string
+		if v, ok := resp.Data["bound_cidrs"].([]interface{}); ok {
+			for _, cidr := range v {
+				found = append(found, cidr.(string))
+			}
+		}
+		if !reflect.DeepEqual(found, cidrs) {
+			return fmt.Errorf("expected bound CIDRs %v, got %v", cidrs, found)
+		}
+		return nil
+	}
+}
+
 func TestResourceToken_lookup(t *testing.T) {
 	resource.Test(t, resource.TestCase{
 		Providers:    testProviders,
@@ -325,6 +687,28 @@ func testResourceTokenLookup(n string) resource.TestCheckFunc {
 	}
 }
 
+// testResourceTokenUse consumes one use of the token.
+func testResourceTokenUse(n string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		client, err := testProvider.Meta().(*api.Client).Clone()
+		if err != nil {
+			return err
+		}
+		client.SetToken(rs.Primary.Attributes["client_token"])
+
+		if _, err := client.Auth().Token().LookupSelf(); err != nil {
+			return fmt.Errorf("Token could not be used: %s", err)
+		}
+
+		return nil
+	}
+}
+
 func testResourceTokenCheckExpireTime(n string) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
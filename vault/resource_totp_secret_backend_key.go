@@ -0,0 +1,274 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/validation"
+	"github.com/hashicorp/vault/api"
+)
+
+func totpSecretBackendKeyResource() *schema.Resource {
+	return &schema.Resource{
+		Create: totpSecretBackendKeyCreate,
+		Read:   totpSecretBackendKeyRead,
+		Delete: totpSecretBackendKeyDelete,
+		Importer: &schema.ResourceImporter{
+			State: totpSecretBackendKeyImport,
+		},
+
+		// Vault has no way to update a key, so every change replaces it.
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The path of the TOTP secret backend the key belongs to.",
+				// standardise on no beginning or trailing slashes
+				StateFunc: func(v interface{}) string {
+					return strings.Trim(v.(string), "/")
+				},
+			},
+			"name": {
+				Type:         schema.TypeString,
+				Required:     true,
+				ForceNew:     true,
+				Description:  "Name of the key.",
+				ValidateFunc: validateNoTrailingSlash,
+			},
+			"generate": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Whether Vault generates the key, acting as a TOTP provider. Otherwise the key is given by key_url or key, and Vault acts as a TOTP generator.",
+				// Vault doesn't report how a key was created, so it's unknown
+				// after import.
+				DiffSuppressFunc: func(k, old, new string, d *schema.ResourceData) bool {
+					return d.Id() != "" && old == ""
+				},
+			},
+			"exported": {
+				Type:        schema.TypeBool,
+				Optional:    true,
+				ForceNew:    true,
+				Default:     true,
+				Description: "Whether a generated key is returned as url and barcode. Only used with generate.",
+			},
+			"key_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      20,
+				Description:  "The size in bytes of a generated key. Only used with generate.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"key_url": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"key"},
+				Description:   "The otpauth:// URL of an existing key. Only used without generate.",
+			},
+			"key": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				Sensitive:     true,
+				ConflictsWith: []string{"key_url"},
+				Description:   "The base32 encoded value of an existing key. Only used without generate.",
+			},
+			"issuer": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The name of the key's issuing organization. Required with generate.",
+			},
+			"account_name": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+				Description: "The name of the account the key belongs to. Required with generate.",
+			},
+			"algorithm": {
+				Type:         schema.TypeString,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "The hashing algorithm used to generate codes. One of \"SHA1\", \"SHA256\" or \"SHA512\". Defaults to \"SHA1\", or the value in key_url.",
+				ValidateFunc: validation.StringInSlice([]string{"SHA1", "SHA256", "SHA512"}, false),
+			},
+			"digits": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "The number of digits in generated codes. One of 6 or 8. Defaults to 6, or the value in key_url.",
+				ValidateFunc: validation.IntInSlice([]int{6, 8}),
+			},
+			"period": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				ForceNew:     true,
+				Description:  "The length of time in seconds a code is valid for. Defaults to 30, or the value in key_url.",
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"skew": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				Description:  "The number of periods before and after the current one a code is still accepted in. One of 0 or 1. Only used with generate.",
+				ValidateFunc: validation.IntBetween(0, 1),
+			},
+			"qr_size": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      200,
+				Description:  "The pixel size of the square barcode. 0 disables the barcode. Only used with generate.",
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+			"url": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The otpauth:// URL of a generated key.",
+			},
+			"barcode": {
+				Type:        schema.TypeString,
+				Computed:    true,
+				Sensitive:   true,
+				Description: "The base64 encoded PNG barcode of a generated key.",
+			},
+		},
+	}
+}
+
+func totpSecretBackendKeyCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	backend := strings.Trim(d.Get("backend").(string), "/")
+	path := totpSecretBackendKeyPath(backend, d.Get("name").(string))
+
+	// Vault fills in what isn't set from key_url, or its defaults.
+	data := map[string]interface{}{}
+	for _, k := range []string{"issuer", "account_name", "algorithm", "digits", "period"} {
+		if v, ok := d.GetOk(k); ok {
+			data[k] = v
+		}
+	}
+	if d.Get("generate").(bool) {
+		data["generate"] = true
+		for _, k := range []string{"exported", "key_size", "skew", "qr_size"} {
+			data[k] = d.Get(k)
+		}
+	} else {
+		data["url"] = d.Get("key_url").(string)
+		data["key"] = d.Get("key").(string)
+	}
+
+	log.Printf("[DEBUG] Creating TOTP key %q", path)
+	resp, err := client.Logical().Write(path, data)
+	if err != nil {
+		return fmt.Errorf("error creating TOTP key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Created TOTP key %q", path)
+
+	d.SetId(path)
+
+	// The generated key is only returned once.
+	if resp != nil {
+		d.Set("url", resp.Data["url"])
+		d.Set("barcode", resp.Data["barcode"])
+	}
+
+	return totpSecretBackendKeyRead(d, meta)
+}
+
+func totpSecretBackendKeyRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+	backend, name, err := totpSecretBackendKeyParsePath(path)
+	if err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Reading TOTP key %q", path)
+	resp, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading TOTP key %q: %s", path, err)
+	}
+	if resp == nil {
+		log.Printf("[WARN] TOTP key %q not found, removing from state", path)
+		d.SetId("")
+		return nil
+	}
+	log.Printf("[DEBUG] Read TOTP key %q", path)
+
+	d.Set("backend", backend)
+	d.Set("name", name)
+	for _, k := range []string{"issuer", "account_name", "algorithm"} {
+		d.Set(k, resp.Data[k])
+	}
+
+	for _, k := range []string{"digits", "period"} {
+		if v, ok := resp.Data[k].(json.Number); ok {
+			n, err := v.Int64()
+			if err != nil {
+				return fmt.Errorf("expected %s of %q to be a number, got %q", k, path, v)
+			}
+			d.Set(k, n)
+		}
+	}
+
+	return nil
+}
+
+// totpSecretBackendKeyImport sets the arguments only used when the key is
+// created to their defaults, since Vault doesn't return them, so the first plan
+// after an import doesn't replace the key.
+func totpSecretBackendKeyImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	s := totpSecretBackendKeyResource().Schema
+	for _, k := range []string{"exported", "key_size", "skew", "qr_size"} {
+		if err := d.Set(k, s[k].Default); err != nil {
+			return nil, err
+		}
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+func totpSecretBackendKeyDelete(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Deleting TOTP key %q", path)
+	if _, err := client.Logical().Delete(path); err != nil {
+		return fmt.Errorf("error deleting TOTP key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Deleted TOTP key %q", path)
+
+	return nil
+}
+
+func totpSecretBackendKeyPath(backend, name string) string {
+	return strings.Trim(backend, "/") + "/keys/" + strings.Trim(name, "/")
+}
+
+func totpSecretBackendKeyParsePath(path string) (string, string, error) {
+	i := strings.LastIndex(path, "/keys/")
+	if i <= 0 || i+len("/keys/") == len(path) {
+		return "", "", fmt.Errorf("expected a path of the form <backend>/keys/<name>, got %q", path)
+	}
+
+	return path[:i], path[i+len("/keys/"):], nil
+}
@@ -0,0 +1,201 @@
+package vault
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+	"github.com/hashicorp/terraform-plugin-sdk/terraform"
+	"github.com/hashicorp/vault/api"
+)
+
+func TestAccTOTPSecretBackendKey_generate(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-totp")
+	resourceName := "vault_totp_secret_backend_key.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccTOTPSecretBackendKeyCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTOTPSecretBackendKeyConfig(backend, `
+  generate     = true
+  issuer       = "Vault"
+  account_name = "test@example.com"
+  digits       = 8
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "backend", backend),
+					resource.TestCheckResourceAttr(resourceName, "name", "test"),
+					resource.TestCheckResourceAttr(resourceName, "issuer", "Vault"),
+					resource.TestCheckResourceAttr(resourceName, "account_name", "test@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "algorithm", "SHA1"),
+					resource.TestCheckResourceAttr(resourceName, "digits", "8"),
+					resource.TestCheckResourceAttr(resourceName, "period", "30"),
+					resource.TestMatchResourceAttr(resourceName, "url", regexp.MustCompile("^otpauth://totp/Vault:test@example.com\\?")),
+					resource.TestCheckResourceAttrSet(resourceName, "barcode"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				// Vault doesn't report whether it generated the key, and only
+				// returns the generated key once.
+				ImportStateVerifyIgnore: []string{"generate", "url", "barcode"},
+			},
+			{
+				Config: testAccTOTPSecretBackendKeyConfig(backend, `
+  generate     = true
+  issuer       = "Vault"
+  account_name = "test@example.com"
+  digits       = 8
+`),
+				PlanOnly: true,
+			},
+		},
+	})
+}
+
+func TestAccTOTPSecretBackendKey_keyURL(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-totp")
+	resourceName := "vault_totp_secret_backend_key.test"
+
+	resource.Test(t, resource.TestCase{
+		Providers:    testProviders,
+		PreCheck:     func() { testAccPreCheck(t) },
+		CheckDestroy: testAccTOTPSecretBackendKeyCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTOTPSecretBackendKeyConfig(backend, `
+  key_url = "otpauth://totp/Example:alice@example.com?secret=JBSWY3DPEHPK3PXP&issuer=Example&algorithm=SHA256&digits=8&period=60"
+`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "issuer", "Example"),
+					resource.TestCheckResourceAttr(resourceName, "account_name", "alice@example.com"),
+					resource.TestCheckResourceAttr(resourceName, "algorithm", "SHA256"),
+					resource.TestCheckResourceAttr(resourceName, "digits", "8"),
+					resource.TestCheckResourceAttr(resourceName, "period", "60"),
+					resource.TestCheckResourceAttr(resourceName, "url", ""),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTOTPCode(t *testing.T) {
+	backend := acctest.RandomWithPrefix("tf-test-totp")
+
+	resource.Test(t, resource.TestCase{
+		Providers: testProviders,
+		PreCheck:  func() { testAccPreCheck(t) },
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTOTPSecretBackendKeyConfig(backend, `
+  key = "JBSWY3DPEHPK3PXP"
+`) + `
+data "vault_totp_code" "test" {
+  backend = vault_mount.test.path
+  name    = vault_totp_secret_backend_key.test.name
+}
+
+data "vault_totp_code" "validate" {
+  backend       = vault_mount.test.path
+  name          = vault_totp_secret_backend_key.test.name
+  validate_code = "000000"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestMatchResourceAttr("data.vault_totp_code.test", "code", regexp.MustCompile("^[0-9]{6}$")),
+					resource.TestCheckResourceAttr("data.vault_totp_code.validate", "code", ""),
+					resource.TestCheckResourceAttrSet("data.vault_totp_code.validate", "valid"),
+				),
+			},
+		},
+	})
+}
+
+func TestTOTPSecretBackendKeyParsePath(t *testing.T) {
+	backend, name, err := totpSecretBackendKeyParsePath("totp/nested/keys/alice")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if backend != "totp/nested" || name != "alice" {
+		t.Errorf("expected backend %q and name %q, got %q and %q", "totp/nested", "alice", backend, name)
+	}
+
+	for _, path := range []string{"totp/keys/", "keys/alice", "totp/alice"} {
+		if _, _, err := totpSecretBackendKeyParsePath(path); err == nil {
+			t.Errorf("expected an error parsing %q", path)
+		}
+	}
+}
+
+func TestTOTPSecretBackendKey_importDiff(t *testing.T) {
+	r := totpSecretBackendKeyResource()
+	d := r.Data(&terraform.InstanceState{ID: "totp/keys/test"})
+	imported, err := totpSecretBackendKeyImport(d, nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	// What Read sets from Vault's response.
+	d = imported[0]
+	d.Set("backend", "totp")
+	d.Set("name", "test")
+	d.Set("issuer", "Vault")
+	d.Set("account_name", "test@example.com")
+	d.Set("algorithm", "SHA1")
+	d.Set("digits", 6)
+	d.Set("period", 30)
+
+	diff, err := r.Diff(d.State(), terraform.NewResourceConfigRaw(map[string]interface{}{
+		"backend":      "totp",
+		"name":         "test",
+		"generate":     true,
+		"issuer":       "Vault",
+		"account_name": "test@example.com",
+	}), nil)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if !diff.Empty() {
+		t.Fatalf("expected no diff after import, got %#v", diff.Attributes)
+	}
+}
+
+func testAccTOTPSecretBackendKeyCheckDestroy(s *terraform.State) error {
+	client := testProvider.Meta().(*api.Client)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "vault_totp_secret_backend_key" {
+			continue
+		}
+		secret, err := client.Logical().Read(rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+		if secret != nil {
+			return fmt.Errorf("key %q still exists", rs.Primary.ID)
+		}
+	}
+	return nil
+}
+
+func testAccTOTPSecretBackendKeyConfig(backend, key string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "test" {
+  path = "%s"
+  type = "totp"
+}
+
+resource "vault_totp_secret_backend_key" "test" {
+  backend = vault_mount.test.path
+  name    = "test"
+%s
+}
+`, backend, key)
+}
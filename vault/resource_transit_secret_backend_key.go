@@ -96,9 +96,11 @@ func transitSecretBackendKeyResource() *schema.Resource {
 				Description: "Latest key version in use in the keyring",
 			},
 			"min_available_version": {
-				Type:        schema.TypeInt,
-				Computed:    true,
-				Description: "Minimum key version available for use.",
+				Type:         schema.TypeInt,
+				Optional:     true,
+				Computed:     true,
+				Description:  "Minimum key version available for use. Key versions below this are permanently trimmed from the keyring.",
+				ValidateFunc: validation.IntAtLeast(1),
 			},
 			"min_decryption_version": {
 				Type:        schema.TypeInt,
@@ -139,6 +141,11 @@ func transitSecretBackendKeyResource() *schema.Resource {
 				Computed:    true,
 				Description: "Whether or not the key supports signing, based on key type.",
 			},
+			"convergent_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Version of the convergent nonce derivation used by the key, if convergent_encryption is enabled.",
+			},
 		},
 		CustomizeDiff: customdiff.All(
 			customdiff.ValidateChange("exportable", func(old, new, meta interface{}) error {
@@ -165,6 +172,15 @@ func transitSecretBackendKeyResource() *schema.Resource {
 				}
 				return nil
 			}),
+			transitSecretBackendKeyValidateTrim,
+			customdiff.IfValue("convergent_encryption", func(v, meta interface{}) bool {
+				return v.(bool)
+			}, func(d *schema.ResourceDiff, meta interface{}) error {
+				if !d.NewValueKnown("derived") || d.Get("derived").(bool) {
+					return nil
+				}
+				return fmt.Errorf("'convergent_encryption' requires 'derived' to be enabled")
+			}),
 			customdiff.ForceNewIfChange("exportable", func(old, new, meta interface{}) bool {
 				return !new.(bool) && old.(bool)
 			}),
@@ -185,7 +201,7 @@ func transitSecretBackendKeyCreate(d *schema.ResourceData, meta interface{}) err
 
 	configData := map[string]interface{}{
 		"min_decryption_version": d.Get("min_decryption_version").(int),
-		"min_encryption_versoin": d.Get("min_encryption_version").(int),
+		"min_encryption_version": d.Get("min_encryption_version").(int),
 		"deletion_allowed":       d.Get("deletion_allowed").(bool),
 		"exportable":             d.Get("exportable").(bool),
 		"allow_plaintext_backup": d.Get("allow_plaintext_backup").(bool),
@@ -208,6 +224,12 @@ func transitSecretBackendKeyCreate(d *schema.ResourceData, meta interface{}) err
 		return fmt.Errorf("error setting configuration for transit secret backend key %q: %s", path, conferr)
 	}
 
+	if v, ok := d.GetOk("min_available_version"); ok {
+		if err := transitSecretBackendKeyTrim(client, path, v.(int)); err != nil {
+			return err
+		}
+	}
+
 	log.Printf("[DEBUG] Created encryption key %s on transit secret backend %q", name, backend)
 	d.SetId(path)
 	return transitSecretBackendKeyRead(d, meta)
@@ -252,6 +274,15 @@ func transitSecretBackendKeyRead(d *schema.ResourceData, meta interface{}) error
 		convergentEncryption = ce
 	}
 
+	// Vault only reports the convergent version for convergent keys.
+	var convergentVersion int64
+	if v, ok := secret.Data["convergent_encryption_version"].(json.Number); ok {
+		convergentVersion, err = v.Int64()
+		if err != nil {
+			return fmt.Errorf("expected convergent_encryption_version %q to be a number, and it isn't", v)
+		}
+	}
+
 	latestVersion, err := secret.Data["latest_version"].(json.Number).Int64()
 	if err != nil {
 		return fmt.Errorf("expected latest_version %q to be a number, and it isn't", secret.Data["latest_version"])
@@ -292,6 +323,7 @@ func transitSecretBackendKeyRead(d *schema.ResourceData, meta interface{}) error
 	d.Set("name", name)
 	d.Set("allow_plaintext_backup", secret.Data["allow_plaintext_backup"].(bool))
 	d.Set("convergent_encryption", convergentEncryption)
+	d.Set("convergent_version", convergentVersion)
 	d.Set("deletion_allowed", secret.Data["deletion_allowed"].(bool))
 	d.Set("derived", secret.Data["derived"].(bool))
 	d.Set("exportable", secret.Data["exportable"].(bool))
@@ -326,11 +358,63 @@ func transitSecretBackendKeyUpdate(d *schema.ResourceData, meta interface{}) err
 	if err != nil {
 		return fmt.Errorf("error updating transit secret backend key %q: %s", path, err)
 	}
+
+	if d.HasChange("min_available_version") {
+		if err := transitSecretBackendKeyTrim(client, path, d.Get("min_available_version").(int)); err != nil {
+			return err
+		}
+	}
 	log.Printf("[DEBUG] Updated transit secret backend key %q", path)
 
 	return transitSecretBackendKeyRead(d, meta)
 }
 
+// transitSecretBackendKeyTrim permanently removes all key versions below
+// minAvailableVersion. The key's configuration must already have been written,
+// since Vault checks the trim against the stored minimum versions.
+func transitSecretBackendKeyTrim(client *api.Client, path string, minAvailableVersion int) error {
+	log.Printf("[DEBUG] Trimming transit secret backend key %q to version %d", path, minAvailableVersion)
+	data := map[string]interface{}{
+		"min_available_version": minAvailableVersion,
+	}
+	if _, err := client.Logical().Write(path+"/trim", data); err != nil {
+		return fmt.Errorf("error trimming transit secret backend key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Trimmed transit secret backend key %q", path)
+	return nil
+}
+
+// transitSecretBackendKeyValidateTrim rejects a min_available_version that
+// Vault would refuse, so the problem is reported at plan time.
+func transitSecretBackendKeyValidateTrim(d *schema.ResourceDiff, meta interface{}) error {
+	for _, k := range []string{"min_available_version", "min_encryption_version", "min_decryption_version"} {
+		if !d.NewValueKnown(k) {
+			return nil
+		}
+	}
+	return transitSecretBackendKeyCheckMinAvailableVersion(
+		d.Get("min_available_version").(int),
+		d.Get("min_encryption_version").(int),
+		d.Get("min_decryption_version").(int),
+	)
+}
+
+func transitSecretBackendKeyCheckMinAvailableVersion(minAvailable, minEncryption, minDecryption int) error {
+	if minAvailable == 0 {
+		return nil
+	}
+	if minEncryption == 0 {
+		return fmt.Errorf("'min_available_version' (%d) requires 'min_encryption_version' to be set to a version of at least %d", minAvailable, minAvailable)
+	}
+	if minAvailable > minEncryption {
+		return fmt.Errorf("'min_available_version' (%d) cannot be greater than 'min_encryption_version' (%d)", minAvailable, minEncryption)
+	}
+	if minAvailable > minDecryption {
+		return fmt.Errorf("'min_available_version' (%d) cannot be greater than 'min_decryption_version' (%d)", minAvailable, minDecryption)
+	}
+	return nil
+}
+
 func transitSecretBackendKeyDelete(d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 
@@ -0,0 +1,105 @@
+package vault
+
+import (
+	"encoding/json"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/vault/api"
+)
+
+func transitSecretBackendKeyRotationResource() *schema.Resource {
+	return &schema.Resource{
+		Create: transitSecretBackendKeyRotationCreate,
+		Read:   transitSecretBackendKeyRotationRead,
+		Delete: transitSecretBackendKeyRotationDelete,
+
+		Schema: map[string]*schema.Schema{
+			"backend": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "The Transit secret backend the key belongs to.",
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Required:    true,
+				ForceNew:    true,
+				Description: "Name of the encryption key to rotate.",
+			},
+			"triggers": {
+				Type:        schema.TypeMap,
+				Optional:    true,
+				ForceNew:    true,
+				Description: "Arbitrary map of values that, when changed, will rotate the key again.",
+				Elem: &schema.Schema{
+					Type: schema.TypeString,
+				},
+			},
+			"latest_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Latest key version in use in the keyring.",
+			},
+			"min_available_version": {
+				Type:        schema.TypeInt,
+				Computed:    true,
+				Description: "Minimum key version available for use.",
+			},
+		},
+	}
+}
+
+func transitSecretBackendKeyRotationCreate(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := transitSecretBackendKeyPath(d.Get("backend").(string), d.Get("name").(string))
+
+	log.Printf("[DEBUG] Rotating transit key %q", path)
+	if _, err := client.Logical().Write(path+"/rotate", nil); err != nil {
+		return fmt.Errorf("error rotating transit key %q: %s", path, err)
+	}
+	log.Printf("[DEBUG] Rotated transit key %q", path)
+
+	d.SetId(path)
+	return transitSecretBackendKeyRotationRead(d, meta)
+}
+
+func transitSecretBackendKeyRotationRead(d *schema.ResourceData, meta interface{}) error {
+	client := meta.(*api.Client)
+
+	path := d.Id()
+
+	log.Printf("[DEBUG] Reading transit key %q", path)
+	secret, err := client.Logical().Read(path)
+	if err != nil {
+		return fmt.Errorf("error reading transit key %q: %s", path, err)
+	}
+	if secret == nil {
+		log.Printf("[WARN] Transit key %q not found, removing rotation from state", path)
+		d.SetId("")
+		return nil
+	}
+
+	latestVersion, err := secret.Data["latest_version"].(json.Number).Int64()
+	if err != nil {
+		return fmt.Errorf("expected latest_version %q to be a number, and it isn't", secret.Data["latest_version"])
+	}
+	minAvailableVersion, err := secret.Data["min_available_version"].(json.Number).Int64()
+	if err != nil {
+		return fmt.Errorf("expected min_available_version %q to be a number, and it isn't", secret.Data["min_available_version"])
+	}
+
+	d.Set("latest_version", latestVersion)
+	d.Set("min_available_version", minAvailableVersion)
+
+	return nil
+}
+
+func transitSecretBackendKeyRotationDelete(d *schema.ResourceData, meta interface{}) error {
+	// Earlier versions stay usable for decryption down to the key's
+	// min_decryption_version, but the key can't be rolled back to one.
+	log.Printf("[DEBUG] Removing rotation of transit key %q from state; the key is left as it is", d.Id())
+	return nil
+}
@@ -0,0 +1,66 @@
+package vault
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/resource"
+)
+
+func TestTransitSecretBackendKeyRotation(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+	name := acctest.RandomWithPrefix("key")
+	resourceName := "vault_transit_secret_backend_key_rotation.test"
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testTransitSecretBackendKeyCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testTransitSecretBackendKeyRotationConfig(name, backend, "2021-01"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(resourceName, "latest_version", "2"),
+					resource.TestCheckResourceAttr(resourceName, "min_available_version", "0"),
+				),
+			},
+			{
+				// Unchanged triggers must not rotate the key again.
+				Config: testTransitSecretBackendKeyRotationConfig(name, backend, "2021-01"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "latest_version", "2"),
+			},
+			{
+				Config: testTransitSecretBackendKeyRotationConfig(name, backend, "2021-02"),
+				Check:  resource.TestCheckResourceAttr(resourceName, "latest_version", "3"),
+			},
+		},
+	})
+}
+
+func testTransitSecretBackendKeyRotationConfig(name, path, period string) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "transit" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  backend          = vault_mount.transit.path
+  name             = "%s"
+  deletion_allowed = true
+
+  lifecycle {
+    ignore_changes = [keys, latest_version]
+  }
+}
+
+resource "vault_transit_secret_backend_key_rotation" "test" {
+  backend = vault_mount.transit.path
+  name    = vault_transit_secret_backend_key.test.name
+
+  triggers = {
+    period = "%s"
+  }
+}
+`, path, name, period)
+}
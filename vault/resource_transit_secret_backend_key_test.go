@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/terraform"
 	"github.com/hashicorp/vault/api"
 	"regexp"
+	"strings"
 	"testing"
 )
 
@@ -143,6 +144,97 @@ func TestTransitSecretBackendKey_import(t *testing.T) {
 	})
 }
 
+func TestTransitSecretBackendKey_trim(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+	name := acctest.RandomWithPrefix("key")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testTransitSecretBackendKeyCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testTransitSecretBackendKeyConfig_basic(name, backend),
+				Check:  resource.TestCheckResourceAttr("vault_transit_secret_backend_key.test", "latest_version", "1"),
+			},
+			{
+				PreConfig: func() {
+					client := testProvider.Meta().(*api.Client)
+					for i := 0; i < 2; i++ {
+						if _, err := client.Logical().Write(transitSecretBackendKeyPath(backend, name)+"/rotate", nil); err != nil {
+							t.Fatal(err)
+						}
+					}
+				},
+				Config:      testTransitSecretBackendKeyConfig_trim(name, backend, 3, 2, 3),
+				ExpectError: regexp.MustCompile(`'min_available_version' \(3\) cannot be greater than 'min_encryption_version' \(2\)`),
+			},
+			{
+				Config: testTransitSecretBackendKeyConfig_trim(name, backend, 3, 3, 3),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_transit_secret_backend_key.test", "latest_version", "3"),
+					resource.TestCheckResourceAttr("vault_transit_secret_backend_key.test", "min_available_version", "3"),
+					resource.TestCheckResourceAttr("vault_transit_secret_backend_key.test", "keys.#", "1"),
+				),
+			},
+		},
+	})
+}
+
+func TestTransitSecretBackendKey_convergent(t *testing.T) {
+	backend := acctest.RandomWithPrefix("transit")
+	name := acctest.RandomWithPrefix("key")
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testProviders,
+		CheckDestroy: testTransitSecretBackendKeyCheckDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config:      testTransitSecretBackendKeyConfig_convergent(name, backend, false),
+				ExpectError: regexp.MustCompile(`'convergent_encryption' requires 'derived' to be enabled`),
+			},
+			{
+				Config: testTransitSecretBackendKeyConfig_convergent(name, backend, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("vault_transit_secret_backend_key.test", "convergent_encryption", "true"),
+					resource.TestCheckResourceAttr("vault_transit_secret_backend_key.test", "derived", "true"),
+					resource.TestCheckResourceAttr("vault_transit_secret_backend_key.test", "convergent_version", "3"),
+				),
+			},
+			{
+				ResourceName:      "vault_transit_secret_backend_key.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestTransitSecretBackendKeyCheckMinAvailableVersion(t *testing.T) {
+	tests := []struct {
+		minAvailable, minEncryption, minDecryption int
+		expectErr                                  string
+	}{
+		{0, 0, 1, ""},
+		{2, 2, 2, ""},
+		{2, 3, 4, ""},
+		{2, 0, 2, "requires 'min_encryption_version'"},
+		{3, 2, 3, "cannot be greater than 'min_encryption_version'"},
+		{3, 3, 2, "cannot be greater than 'min_decryption_version'"},
+	}
+	for _, tt := range tests {
+		err := transitSecretBackendKeyCheckMinAvailableVersion(tt.minAvailable, tt.minEncryption, tt.minDecryption)
+		if tt.expectErr == "" {
+			if err != nil {
+				t.Errorf("unexpected error for %+v: %s", tt, err)
+			}
+			continue
+		}
+		if err == nil || !strings.Contains(err.Error(), tt.expectErr) {
+			t.Errorf("expected error containing %q for %+v, got %v", tt.expectErr, tt, err)
+		}
+	}
+}
+
 func testTransitSecretBackendKeyConfig_basic(name, path string) string {
 	return fmt.Sprintf(`
 resource "vault_mount" "transit" {
@@ -158,6 +250,23 @@ resource "vault_transit_secret_backend_key" "test" {
 `, path, name)
 }
 
+func testTransitSecretBackendKeyConfig_convergent(name, path string, derived bool) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "transit" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  backend               = "${vault_mount.transit.path}"
+  name                  = "%s"
+  deletion_allowed      = true
+  convergent_encryption = true
+  derived               = %t
+}
+`, path, name, derived)
+}
+
 func testTransitSecretBackendKeyConfig_rsa4096(name, path string) string {
 	return fmt.Sprintf(`
 resource "vault_mount" "transit" {
@@ -232,6 +341,24 @@ resource "vault_transit_secret_backend_key" "test" {
 `, path, name)
 }
 
+func testTransitSecretBackendKeyConfig_trim(name, path string, minAvailable, minEncryption, minDecryption int) string {
+	return fmt.Sprintf(`
+resource "vault_mount" "transit" {
+  path = "%s"
+  type = "transit"
+}
+
+resource "vault_transit_secret_backend_key" "test" {
+  backend = "${vault_mount.transit.path}"
+  name = "%s"
+  deletion_allowed = true
+  min_available_version = %d
+  min_encryption_version = %d
+  min_decryption_version = %d
+}
+`, path, name, minAvailable, minEncryption, minDecryption)
+}
+
 func testTransitSecretBackendKeyCheckDestroy(s *terraform.State) error {
 	client := testProvider.Meta().(*api.Client)
 
@@ -7,6 +7,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
 	"github.com/hashicorp/vault/api"
 	"log"
+	"strings"
 )
 
 func readSentinelPolicy(client *api.Client, policyType string, name string) (map[string]interface{}, error) {
@@ -72,6 +73,12 @@ func ValidateSentinelEnforcementLevel(v interface{}, k string) (ws []string, err
 	return
 }
 
+// sentinelPolicyDiffSuppress ignores differences in the whitespace around the
+// Sentinel source, such as the trailing newline added by heredocs.
+func sentinelPolicyDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	return strings.TrimSpace(old) == strings.TrimSpace(new)
+}
+
 func sentinelPolicyDelete(policyType string, d *schema.ResourceData, meta interface{}) error {
 	client := meta.(*api.Client)
 
@@ -97,6 +104,11 @@ func sentinelPolicyRead(policyType string, attributes []string, d *schema.Resour
 	if err != nil {
 		return fmt.Errorf("error reading from Vault: %s", err)
 	}
+	if policy == nil {
+		log.Printf("[WARN] %s policy %s not found, removing from state", policyType, name)
+		d.SetId("")
+		return nil
+	}
 
 	for _, value := range attributes {
 		d.Set(value, policy[value])
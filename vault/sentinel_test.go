@@ -0,0 +1,25 @@
+package vault
+
+import (
+	"testing"
+)
+
+func TestSentinelPolicyDiffSuppress(t *testing.T) {
+	tests := []struct {
+		old      string
+		new      string
+		suppress bool
+	}{
+		{"main = rule { true }", "main = rule { true }\n", true},
+		{"main = rule { true }\n", "main = rule { true }\n\n", true},
+		{"\nmain = rule { true }", "main = rule { true }", true},
+		{"main = rule { true }", "main = rule { false }\n", false},
+		{"main = rule {\n  true\n}", "main = rule {\n\ttrue\n}", false},
+	}
+
+	for _, test := range tests {
+		if actual := sentinelPolicyDiffSuppress("policy", test.old, test.new, nil); actual != test.suppress {
+			t.Errorf("old %q, new %q: expected suppress %t, got %t", test.old, test.new, test.suppress, actual)
+		}
+	}
+}
@@ -1,14 +1,25 @@
 package vault
 
 import (
+	"encoding/json"
 	"errors"
 	"fmt"
+	"reflect"
+	"regexp"
 	"strings"
 	"time"
 
 	"github.com/gosimple/slug"
+	"github.com/hashicorp/terraform-plugin-sdk/helper/schema"
+	"github.com/hashicorp/terraform-provider-vault/util"
+	yaml "github.com/zclconf/go-cty-yaml"
+	ctyjson "github.com/zclconf/go-cty/cty/json"
 )
 
+const utcTimestampFormat = "2006-01-02T15:04:05Z"
+
+var cronFieldRegex = regexp.MustCompile(`^[0-9A-Za-z*?/,-]+$`)
+
 func validateStringSlug(i interface{}, k string) (s []string, es []error) {
 	v, ok := i.(string)
 	if !ok {
@@ -35,6 +46,26 @@ func validateDuration(i interface{}, k string) (s []string, es []error) {
 	return
 }
 
+// validateDurationSeconds checks that the value is a non-negative number of
+// seconds or duration string.
+func validateDurationSeconds(i interface{}, k string) (s []string, es []error) {
+	v, ok := i.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	seconds, err := util.ParseDurationSeconds(v)
+	if err != nil {
+		es = append(es, fmt.Errorf("expected %s to be a number of seconds or a duration string, got %q", k, v))
+		return
+	}
+	if seconds < 0 {
+		es = append(es, fmt.Errorf("expected %s to not be negative, got %q", k, v))
+	}
+	return
+}
+
 func validateNoTrailingSlash(i interface{}, k string) (s []string, es []error) {
 	v, ok := i.(string)
 	if !ok {
@@ -47,3 +78,124 @@ func validateNoTrailingSlash(i interface{}, k string) (s []string, es []error) {
 	}
 	return
 }
+
+// validateUTCTimestamp checks that the value is a UTC timestamp in the
+// YYYY-MM-ddTHH:MM:SSZ format that Vault expects, e.g. for PKI not_after.
+func validateUTCTimestamp(i interface{}, k string) (s []string, es []error) {
+	v, ok := i.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if _, err := time.Parse(utcTimestampFormat, v); err != nil {
+		es = append(es, fmt.Errorf("expected %s to be a UTC timestamp in the format YYYY-MM-ddTHH:MM:SSZ, got %q", k, v))
+	}
+	return
+}
+
+// validateCronSchedule checks that the value looks like a standard five field
+// cron schedule, e.g. "0 0 * * SAT". Vault does the full parsing.
+func validateCronSchedule(i interface{}, k string) (s []string, es []error) {
+	v, ok := i.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	fields := strings.Fields(v)
+	if len(fields) != 5 {
+		es = append(es, fmt.Errorf("expected %s to be a cron schedule with 5 fields, got %q", k, v))
+		return
+	}
+	for _, f := range fields {
+		if !cronFieldRegex.MatchString(f) {
+			es = append(es, fmt.Errorf("expected %s to be a cron schedule, got invalid field %q in %q", k, f, v))
+		}
+	}
+	return
+}
+
+// validateKubernetesRoleRules checks that the value is a YAML or JSON
+// document holding a list of RBAC policy rules, e.g.
+//
+//	rules:
+//	- apiGroups: [""]
+//	  resources: ["pods"]
+//	  verbs: ["list"]
+func validateKubernetesRoleRules(i interface{}, k string) (s []string, es []error) {
+	v, ok := i.(string)
+	if !ok {
+		es = append(es, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	doc, err := parseYAMLOrJSON(v)
+	if err != nil {
+		es = append(es, fmt.Errorf("expected %s to be valid YAML or JSON: %s", k, err))
+		return
+	}
+
+	m, ok := doc.(map[string]interface{})
+	if !ok {
+		es = append(es, fmt.Errorf("expected %s to be a mapping with a 'rules' key", k))
+		return
+	}
+	rules, ok := m["rules"].([]interface{})
+	if !ok {
+		es = append(es, fmt.Errorf("expected 'rules' in %s to be a list", k))
+		return
+	}
+
+	for idx, r := range rules {
+		rule, ok := r.(map[string]interface{})
+		if !ok {
+			es = append(es, fmt.Errorf("expected rule %d in %s to be a mapping", idx, k))
+			continue
+		}
+		verbs, ok := rule["verbs"].([]interface{})
+		if !ok || len(verbs) == 0 {
+			es = append(es, fmt.Errorf("expected rule %d in %s to have a non-empty 'verbs' list", idx, k))
+		}
+	}
+	return
+}
+
+// yamlOrJSONDiffSuppress suppresses diffs between YAML or JSON documents
+// that only differ in formatting.
+func yamlOrJSONDiffSuppress(k, old, new string, d *schema.ResourceData) bool {
+	oldDoc, err := parseYAMLOrJSON(old)
+	if err != nil {
+		return false
+	}
+	newDoc, err := parseYAMLOrJSON(new)
+	if err != nil {
+		return false
+	}
+	return reflect.DeepEqual(oldDoc, newDoc)
+}
+
+// parseYAMLOrJSON decodes a YAML document into generic Go values. Since JSON
+// is a subset of YAML, JSON documents are accepted as well.
+func parseYAMLOrJSON(v string) (interface{}, error) {
+	src := []byte(v)
+
+	ty, err := yaml.ImpliedType(src)
+	if err != nil {
+		return nil, err
+	}
+	val, err := yaml.Unmarshal(src, ty)
+	if err != nil {
+		return nil, err
+	}
+	b, err := ctyjson.Marshal(val, ty)
+	if err != nil {
+		return nil, err
+	}
+
+	var doc interface{}
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return nil, err
+	}
+	return doc, nil
+}
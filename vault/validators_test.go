@@ -53,3 +53,108 @@ func TestValidateNoTrailingSlash(t *testing.T) {
 		}
 	}
 }
+
+func TestValidateUTCTimestamp(t *testing.T) {
+	for _, v := range []string{"2030-01-02T15:04:05Z", "1999-12-31T23:59:59Z"} {
+		if _, errs := validateUTCTimestamp(v, "not_after"); len(errs) != 0 {
+			t.Errorf("expected %q to be valid, got %v", v, errs)
+		}
+	}
+	for _, v := range []string{"", "2030-01-02", "2030-01-02T15:04:05+01:00", "72h"} {
+		if _, errs := validateUTCTimestamp(v, "not_after"); len(errs) == 0 {
+			t.Errorf("expected %q to be invalid", v)
+		}
+	}
+}
+
+func TestValidateDurationSeconds(t *testing.T) {
+	for _, v := range []string{"0", "86400", "24h", "1h30m"} {
+		if _, errs := validateDurationSeconds(v, "verification_ttl"); len(errs) != 0 {
+			t.Errorf("expected %q to be valid, got %v", v, errs)
+		}
+	}
+	for _, v := range []string{"", "-1", "-24h", "one day"} {
+		if _, errs := validateDurationSeconds(v, "verification_ttl"); len(errs) == 0 {
+			t.Errorf("expected %q to be invalid", v)
+		}
+	}
+}
+
+func TestValidateCronSchedule(t *testing.T) {
+	for _, v := range []string{"0 0 * * SAT", "*/15 * * * *", "0 2 1-7 JAN,JUL MON"} {
+		if _, errs := validateCronSchedule(v, "rotation_schedule"); len(errs) != 0 {
+			t.Errorf("expected %q to be valid, got %v", v, errs)
+		}
+	}
+	for _, v := range []string{"", "0 0 * *", "0 0 0 * * SAT", "@daily", "0 0 * * SAT;"} {
+		if _, errs := validateCronSchedule(v, "rotation_schedule"); len(errs) == 0 {
+			t.Errorf("expected %q to be invalid", v)
+		}
+	}
+}
+
+func TestValidateKubernetesRoleRules(t *testing.T) {
+	testCases := map[string]struct {
+		val         string
+		expectedErr *regexp.Regexp
+	}{
+		"yaml": {
+			val: `
+rules:
+- apiGroups: [""]
+  resources: ["pods"]
+  verbs: ["list"]
+`,
+		},
+		"json": {
+			val: `{"rules":[{"apiGroups":[""],"resources":["pods"],"verbs":["list"]}]}`,
+		},
+		"malformed": {
+			val:         "rules:\n- apiGroups: [\"\"\n  verbs: list\n",
+			expectedErr: regexp.MustCompile(`expected test_property to be valid YAML or JSON`),
+		},
+		"no rules": {
+			val:         `{"verbs":["list"]}`,
+			expectedErr: regexp.MustCompile(`expected 'rules' in test_property to be a list`),
+		},
+		"missing verbs": {
+			val:         "rules:\n- resources: [\"pods\"]\n",
+			expectedErr: regexp.MustCompile(`expected rule 0 in test_property to have a non-empty 'verbs' list`),
+		},
+	}
+
+	for name, tc := range testCases {
+		_, errs := validateKubernetesRoleRules(tc.val, "test_property")
+
+		if tc.expectedErr == nil {
+			if len(errs) != 0 {
+				t.Fatalf("expected test case %q to produce no errors, got %v", name, errs)
+			}
+			continue
+		}
+
+		if len(errs) != 1 || !tc.expectedErr.MatchString(errs[0].Error()) {
+			t.Fatalf("expected test case %q to produce error matching \"%s\", got %v", name, tc.expectedErr, errs)
+		}
+	}
+}
+
+func TestYAMLOrJSONDiffSuppress(t *testing.T) {
+	yamlRules := `
+rules:
+- apiGroups: [""]
+  resources: ["pods"]
+  verbs: ["list", "get"]
+`
+	jsonRules := `{"rules": [{"verbs": ["list", "get"], "resources": ["pods"], "apiGroups": [""]}]}`
+
+	if !yamlOrJSONDiffSuppress("", yamlRules, jsonRules, nil) {
+		t.Fatal("expected equivalent YAML and JSON documents to suppress the diff")
+	}
+	if yamlOrJSONDiffSuppress("", yamlRules, `{"rules": [{"verbs": ["list"]}]}`, nil) {
+		t.Fatal("expected different documents not to suppress the diff")
+	}
+	if yamlOrJSONDiffSuppress("", yamlRules, "rules: [", nil) {
+		t.Fatal("expected malformed documents not to suppress the diff")
+	}
+}